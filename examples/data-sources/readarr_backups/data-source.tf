@@ -0,0 +1,2 @@
+data "readarr_backups" "example" {
+}
\ No newline at end of file
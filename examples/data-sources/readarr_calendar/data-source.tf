@@ -0,0 +1,5 @@
+data "readarr_calendar" "example" {
+  start       = "2023-01-01"
+  end         = "2023-01-08"
+  unmonitored = false
+}
@@ -0,0 +1,2 @@
+data "readarr_development_config" "example" {
+}
\ No newline at end of file
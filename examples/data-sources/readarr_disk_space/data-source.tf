@@ -0,0 +1,2 @@
+data "readarr_disk_space" "example" {
+}
@@ -0,0 +1,2 @@
+data "readarr_download_client_schema" "example" {
+}
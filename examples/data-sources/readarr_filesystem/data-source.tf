@@ -0,0 +1,4 @@
+data "readarr_filesystem" "example" {
+  path          = "/books"
+  include_files = true
+}
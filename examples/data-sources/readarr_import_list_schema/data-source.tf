@@ -0,0 +1,2 @@
+data "readarr_import_list_schema" "example" {
+}
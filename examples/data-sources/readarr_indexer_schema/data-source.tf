@@ -0,0 +1,2 @@
+data "readarr_indexer_schema" "example" {
+}
@@ -0,0 +1,5 @@
+data "readarr_logs" "example" {
+  level       = "error"
+  since       = "2023-01-01T00:00:00Z"
+  max_entries = 10
+}
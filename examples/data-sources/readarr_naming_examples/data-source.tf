@@ -0,0 +1,4 @@
+data "readarr_naming_examples" "example" {
+  author_folder_format = "{Author Name}"
+  standard_book_format = "{Book Title}/{Author Name} - {Book Title}{ (PartNumber)}"
+}
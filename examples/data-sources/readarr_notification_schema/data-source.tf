@@ -0,0 +1,2 @@
+data "readarr_notification_schema" "example" {
+}
@@ -0,0 +1,3 @@
+data "readarr_rename_preview" "example" {
+  author_id = 1
+}
@@ -0,0 +1,3 @@
+data "readarr_retag_preview" "example" {
+  author_id = 1
+}
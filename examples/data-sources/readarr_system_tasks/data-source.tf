@@ -0,0 +1,2 @@
+data "readarr_system_tasks" "example" {
+}
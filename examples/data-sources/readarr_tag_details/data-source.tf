@@ -0,0 +1,3 @@
+data "readarr_tag_details" "example" {
+  label = "example"
+}
@@ -0,0 +1,7 @@
+data "readarr_root_folder" "example" {
+  path = "/books"
+}
+
+data "readarr_unmapped_files" "example" {
+  root_folder_id = data.readarr_root_folder.example.id
+}
@@ -0,0 +1,3 @@
+data "readarr_wanted_cutoff" "example" {
+  monitored = true
+}
@@ -0,0 +1,3 @@
+data "readarr_wanted_missing" "example" {
+  monitored = true
+}
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import readarr_author.example 10
\ No newline at end of file
+terraform import readarr_author.example 10
+
+# import using the author name
+terraform import readarr_author.example "name:J.R.R. Tolkien"
\ No newline at end of file
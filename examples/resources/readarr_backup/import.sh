@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import readarr_backup.example 1
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "readarr_backup" "example" {
+  output_path = "${path.root}/backups/readarr.zip"
+}
\ No newline at end of file
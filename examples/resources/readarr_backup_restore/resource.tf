@@ -0,0 +1,3 @@
+resource "readarr_backup_restore" "example" {
+  archive_path = "${path.root}/backups/readarr.zip"
+}
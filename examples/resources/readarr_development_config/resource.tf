@@ -0,0 +1,7 @@
+resource "readarr_development_config" "example" {
+  metadata_source      = "https://api.bookinfo.pro"
+  console_log_level    = "info"
+  log_rotate           = 50
+  log_sql              = false
+  filter_sentry_events = true
+}
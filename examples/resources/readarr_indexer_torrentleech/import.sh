@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import readarr_indexer_torrentleech.example 1
\ No newline at end of file
+terraform import readarr_indexer_torrentleech.example 1
+
+# import using the name
+terraform import readarr_indexer_torrentleech.example name:Example
\ No newline at end of file
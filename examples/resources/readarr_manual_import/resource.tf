@@ -0,0 +1,6 @@
+resource "readarr_manual_import" "example" {
+  folder      = "/downloads/staging/book"
+  author_id   = 1
+  book_id     = 10
+  import_mode = "move"
+}
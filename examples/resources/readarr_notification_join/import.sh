@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import readarr_notification_join.example 1
\ No newline at end of file
+terraform import readarr_notification_join.example 1
+
+# import using the name
+terraform import readarr_notification_join.example name:Example
\ No newline at end of file
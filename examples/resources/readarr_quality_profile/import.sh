@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import readarr_quality_profile.example 10
\ No newline at end of file
+terraform import readarr_quality_profile.example 10
+
+# import using the name
+terraform import readarr_quality_profile.example name:Example
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "readarr_rename" "example" {
+  author_id = 1
+  triggers = {
+    standard_book_format = readarr_naming.example.standard_book_format
+  }
+}
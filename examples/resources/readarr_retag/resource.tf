@@ -0,0 +1,6 @@
+resource "readarr_retag" "example" {
+  author_id = 1
+  triggers = {
+    write_book_tags = readarr_metadata_config.example.write_book_tags
+  }
+}
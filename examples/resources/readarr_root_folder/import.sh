@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import readarr_root_folder.example 10
\ No newline at end of file
+terraform import readarr_root_folder.example 10
+
+# import using the name or the path
+terraform import readarr_root_folder.example name:/config
\ No newline at end of file
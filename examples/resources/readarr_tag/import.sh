@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import readarr_tag.example 10
\ No newline at end of file
+terraform import readarr_tag.example 10
+
+# import using the label
+terraform import readarr_tag.example name:example
\ No newline at end of file
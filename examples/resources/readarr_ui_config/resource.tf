@@ -0,0 +1,11 @@
+resource "readarr_ui_config" "example" {
+  first_day_of_week           = 0
+  calendar_week_column_header = "ddd M/D"
+  short_date_format           = "MMM D YYYY"
+  long_date_format            = "dddd, MMMM D YYYY"
+  time_format                 = "h(:mm)a"
+  theme                       = "auto"
+  ui_language                 = 1
+  show_relative_dates         = true
+  enable_color_impaired_mode  = false
+}
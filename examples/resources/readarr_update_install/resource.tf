@@ -0,0 +1,6 @@
+data "readarr_updates" "example" {
+}
+
+resource "readarr_update_install" "example" {
+  version = one([for u in data.readarr_updates.example.updates : u.version if u.latest && u.installable])
+}
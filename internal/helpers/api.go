@@ -0,0 +1,165 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+// ErrUnexpectedStatus is returned when a direct API call does not get a successful status code.
+var ErrUnexpectedStatus = errors.New("unexpected status code")
+
+// newAPIRequest builds a request against the client server, reusing its default headers.
+// It is needed for the few endpoints not fully covered by the SDK.
+func newAPIRequest(ctx context.Context, client *readarr.APIClient, method, urlPath string, query url.Values, body io.Reader) (*http.Request, error) {
+	config := client.GetConfig()
+
+	address := strings.TrimSuffix(config.Servers[0].URL, "/") + "/" + strings.TrimPrefix(urlPath, "/")
+	if len(query) != 0 {
+		address += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, address, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for header, value := range config.DefaultHeader {
+		req.Header.Add(header, value)
+	}
+
+	if config.UserAgent != "" {
+		req.Header.Set("User-Agent", config.UserAgent)
+	}
+
+	return req, nil
+}
+
+// doAPIRequest executes the request and returns an error for any non successful status code.
+func doAPIRequest(client *readarr.APIClient, req *http.Request) (*http.Response, error) {
+	httpClient := client.GetConfig().HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+
+		return nil, fmt.Errorf("%w: %s\nDetails:\n%s", ErrUnexpectedStatus, resp.Status, string(body))
+	}
+
+	return resp, nil
+}
+
+// APIJSON calls the given API path sending and receiving JSON payloads.
+// input and output are optional.
+func APIJSON(ctx context.Context, client *readarr.APIClient, method, urlPath string, query url.Values, input, output interface{}) error {
+	var body io.Reader
+
+	if input != nil {
+		payload, err := json.Marshal(input)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := newAPIRequest(ctx, client, method, urlPath, query, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if input != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := doAPIRequest(client, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if output == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(output)
+}
+
+// APIDownload streams the content of the given path into the writer.
+func APIDownload(ctx context.Context, client *readarr.APIClient, urlPath string, writer io.Writer) error {
+	req, err := newAPIRequest(ctx, client, http.MethodGet, urlPath, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := doAPIRequest(client, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(writer, resp.Body)
+
+	return err
+}
+
+// APIUpload sends the given local file as multipart form data.
+func APIUpload(ctx context.Context, client *readarr.APIClient, urlPath, fieldName, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile(fieldName, filepath.Base(filePath))
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(part, file); err != nil {
+		return err
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	req, err := newAPIRequest(ctx, client, http.MethodPost, urlPath, nil, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := doAPIRequest(client, req)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
@@ -0,0 +1,114 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAPIClient(url string) *readarr.APIClient {
+	config := readarr.NewConfiguration()
+	config.AddDefaultHeader("X-Api-Key", "test")
+	config.Servers[0].URL = url
+
+	return readarr.NewAPIClient(config)
+}
+
+func TestAPIJSON(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "test" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		if r.URL.Path == "/api/v1/error" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("bad request"))
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"name":` + string(body) + `,"query":"` + r.URL.Query().Get("page") + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		path     string
+		expected map[string]interface{}
+		err      error
+	}{
+		"working": {
+			path:     "/api/v1/test",
+			expected: map[string]interface{}{"name": "input", "query": "1"},
+		},
+		"error": {
+			path: "/api/v1/error",
+			err:  ErrUnexpectedStatus,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output := map[string]interface{}{}
+			err := APIJSON(context.TODO(), testAPIClient(server.URL), http.MethodPost, test.path, map[string][]string{"page": {"1"}}, "input", &output)
+			assert.True(t, errors.Is(err, test.err))
+			if test.err == nil {
+				assert.Equal(t, test.expected, output)
+			}
+		})
+	}
+}
+
+func TestAPIDownload(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("content of " + r.URL.Path))
+	}))
+	defer server.Close()
+
+	buffer := &bytes.Buffer{}
+	assert.Nil(t, APIDownload(context.TODO(), testAPIClient(server.URL+"/"), "/backup/manual/test.zip", buffer))
+	assert.Equal(t, "content of /backup/manual/test.zip", buffer.String())
+}
+
+func TestAPIUpload(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("restore")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		content, _ := io.ReadAll(file)
+		if header.Filename != "backup.zip" || string(content) != "zip" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	filePath := filepath.Join(t.TempDir(), "backup.zip")
+	assert.Nil(t, os.WriteFile(filePath, []byte("zip"), 0o600))
+
+	assert.Nil(t, APIUpload(context.TODO(), testAPIClient(server.URL), "/api/v1/system/backup/restore/upload", "restore", filePath))
+	assert.NotNil(t, APIUpload(context.TODO(), testAPIClient(server.URL), "/api/v1/system/backup/restore/upload", "other", filePath))
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+// define constants for command management.
+const (
+	CommandPath         = "/api/v1/command"
+	CommandTimeout      = 30 * time.Minute
+	CommandPollInterval = 2 * time.Second
+)
+
+// ErrCommandNotCompleted is returned when a command ends in a status different from completed.
+var ErrCommandNotCompleted = errors.New("command not completed")
+
+// ExecuteCommand sends a command with its optional parameters and waits for its completion.
+func ExecuteCommand(ctx context.Context, client *readarr.APIClient, name string, params map[string]interface{}) (*readarr.CommandResource, error) {
+	command, err := StartCommand(ctx, client, name, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return WaitCommand(ctx, client, command.GetId(), CommandPollInterval)
+}
+
+// StartCommand sends a command with its optional parameters without waiting for it.
+// Parameters are merged at the root of the body, since the SDK command model does not support them.
+func StartCommand(ctx context.Context, client *readarr.APIClient, name string, params map[string]interface{}) (*readarr.CommandResource, error) {
+	body := map[string]interface{}{"name": name}
+	for k, v := range params {
+		body[k] = v
+	}
+
+	command := readarr.NewCommandResource()
+	if err := APIJSON(ctx, client, http.MethodPost, CommandPath, nil, body, command); err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// WaitCommand polls the given command until it reaches a final status or CommandTimeout expires.
+func WaitCommand(ctx context.Context, client *readarr.APIClient, id int32, interval time.Duration) (*readarr.CommandResource, error) {
+	ctx, cancel := context.WithTimeout(ctx, CommandTimeout)
+	defer cancel()
+
+	for {
+		command, _, err := client.CommandApi.GetCommandById(ctx, id).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		switch command.GetStatus() {
+		case readarr.COMMANDSTATUS_COMPLETED:
+			return command, nil
+		case readarr.COMMANDSTATUS_FAILED, readarr.COMMANDSTATUS_ABORTED, readarr.COMMANDSTATUS_CANCELLED, readarr.COMMANDSTATUS_ORPHANED:
+			return command, fmt.Errorf("%w: %s %s: %s %s", ErrCommandNotCompleted, command.GetName(), command.GetStatus(), command.GetMessage(), command.GetException())
+		case readarr.COMMANDSTATUS_QUEUED, readarr.COMMANDSTATUS_STARTED:
+		}
+
+		select {
+		case <-ctx.Done():
+			return command, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		final string
+		err   error
+	}{
+		"completed": {
+			final: "completed",
+		},
+		"failed": {
+			final: "failed",
+			err:   ErrCommandNotCompleted,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := "started"
+				if atomic.AddInt32(&calls, 1) > 1 {
+					status = test.final
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":1,"name":"Backup","status":"` + status + `"}`))
+			}))
+			defer server.Close()
+
+			command, err := WaitCommand(context.TODO(), testAPIClient(server.URL), 1, time.Millisecond)
+			assert.True(t, errors.Is(err, test.err))
+			assert.Equal(t, test.final, string(command.GetStatus()))
+			assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestStartCommand(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["name"] != "RenameAuthor" || body["authorIds"] == nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":2,"name":"RenameAuthor","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	command, err := StartCommand(context.TODO(), testAPIClient(server.URL), "RenameAuthor", map[string]interface{}{"authorIds": []int{1}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), command.GetId())
+}
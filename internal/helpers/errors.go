@@ -17,6 +17,7 @@ const (
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
+	ImplementationMismatchError       = "Implementation Mismatch"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
 )
@@ -0,0 +1,115 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// define constants for filesystem management.
+const (
+	FileSystemPath    = "/api/v1/filesystem"
+	PathNotFoundError = "Path Not Found"
+)
+
+// FileSystemEntry is a directory or file returned by the filesystem endpoint.
+type FileSystemEntry struct {
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Type         string     `json:"type"`
+	Name         string     `json:"name"`
+	Path         string     `json:"path"`
+	Size         int64      `json:"size"`
+}
+
+// FileSystemContent is the content of a directory returned by the filesystem endpoint.
+// The SDK does not decode the response, so it is modeled here.
+type FileSystemContent struct {
+	Parent      string             `json:"parent"`
+	Directories []*FileSystemEntry `json:"directories"`
+	Files       []*FileSystemEntry `json:"files"`
+}
+
+// ListFileSystem browses the given path on the Readarr host.
+func ListFileSystem(ctx context.Context, client *readarr.APIClient, dirPath string, includeFiles bool) (*FileSystemContent, error) {
+	query := url.Values{}
+	query.Set("path", dirPath)
+	query.Set("includeFiles", strconv.FormatBool(includeFiles))
+	query.Set("allowFoldersWithoutTrailingSlashes", "true")
+
+	content := &FileSystemContent{}
+	if err := APIJSON(ctx, client, http.MethodGet, FileSystemPath, query, nil, content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// PathExists checks that a directory or file exists on the Readarr host, looking for it in its parent directory.
+// The endpoint answers with an empty content for missing paths, so the path itself cannot be browsed.
+func PathExists(ctx context.Context, client *readarr.APIClient, fullPath string) (bool, error) {
+	separator := "/"
+	if strings.Contains(fullPath, `\`) {
+		separator = `\`
+	}
+
+	target := strings.TrimRight(fullPath, separator)
+	if target == "" || strings.HasSuffix(target, ":") {
+		// filesystem roots always exist
+		return true, nil
+	}
+
+	parent := target[:strings.LastIndex(target, separator)+1]
+	if parent == "" {
+		return false, nil
+	}
+
+	content, err := ListFileSystem(ctx, client, parent, true)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range append(content.Directories, content.Files...) {
+		if strings.TrimRight(entry.Path, separator) == target {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CheckPlanPaths fails the plan when any of the given string attributes is a path missing on the Readarr host.
+func CheckPlanPaths(ctx context.Context, client *readarr.APIClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes ...string) {
+	// Nothing to check on destroy or with an unconfigured provider
+	if req.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+
+	for _, attribute := range attributes {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+		if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+			continue
+		}
+
+		exists, err := PathExists(ctx, client, value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), ClientError, ParseClientError(Read, "filesystem", err))
+
+			continue
+		}
+
+		if !exists {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), PathNotFoundError, "Path "+value.ValueString()+" does not exist on the Readarr host.")
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathExists(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("path") {
+		case "/":
+			_, _ = w.Write([]byte(`{"parent":"","directories":[{"type":"folder","name":"books","path":"/books/"}],"files":[]}`))
+		case "/books/":
+			_, _ = w.Write([]byte(`{"parent":"/","directories":[],"files":[{"type":"file","name":"script.sh","path":"/books/script.sh"}]}`))
+		case `C:\`:
+			_, _ = w.Write([]byte(`{"parent":"","directories":[{"type":"folder","name":"books","path":"C:\\books\\"}],"files":[]}`))
+		default:
+			_, _ = w.Write([]byte(`{"parent":"","directories":[],"files":[]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		path     string
+		expected bool
+	}{
+		"root": {
+			path:     "/",
+			expected: true,
+		},
+		"directory": {
+			path:     "/books",
+			expected: true,
+		},
+		"trailing": {
+			path:     "/books/",
+			expected: true,
+		},
+		"file": {
+			path:     "/books/script.sh",
+			expected: true,
+		},
+		"windows": {
+			path:     `C:\books`,
+			expected: true,
+		},
+		"missing": {
+			path: "/books/missing",
+		},
+		"relative": {
+			path: "books",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			exists, err := PathExists(context.TODO(), testAPIClient(server.URL), test.path)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, exists)
+		})
+	}
+}
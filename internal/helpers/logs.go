@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+// define constants for log management.
+const (
+	LogPath     = "/api/v1/log"
+	LogPageSize = 100
+)
+
+// ListLogs pages through the log entries from the newest one, stopping at the first entry not after since or when maxEntries are collected.
+// The SDK does not expose paging and level parameters, so the API is called directly.
+func ListLogs(ctx context.Context, client *readarr.APIClient, level string, since time.Time, maxEntries, pageSize int) ([]*readarr.LogResource, error) {
+	var logs []*readarr.LogResource
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("pageSize", strconv.Itoa(pageSize))
+		query.Set("sortKey", "time")
+		query.Set("sortDirection", "descending")
+
+		if level != "" {
+			query.Set("level", level)
+		}
+
+		response := readarr.NewLogResourcePagingResource()
+		if err := APIJSON(ctx, client, http.MethodGet, LogPath, query, nil, response); err != nil {
+			return nil, err
+		}
+
+		for _, log := range response.GetRecords() {
+			if !log.GetTime().After(since) || len(logs) == maxEntries {
+				return logs, nil
+			}
+
+			logs = append(logs, log)
+		}
+
+		if len(response.GetRecords()) < pageSize {
+			return logs, nil
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLogs(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("level") != "error" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"records":[{"id":4,"time":"2023-01-04T00:00:00Z"},{"id":3,"time":"2023-01-03T00:00:00Z"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"records":[{"id":2,"time":"2023-01-02T00:00:00Z"},{"id":1,"time":"2023-01-01T00:00:00Z"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"records":[]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		since      time.Time
+		maxEntries int
+		expected   int
+	}{
+		"all": {
+			maxEntries: 10,
+			expected:   4,
+		},
+		"since": {
+			since:      time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			maxEntries: 10,
+			expected:   2,
+		},
+		"max": {
+			maxEntries: 1,
+			expected:   1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logs, err := ListLogs(context.TODO(), testAPIClient(server.URL), "error", test.since, test.maxEntries, 2)
+			assert.Nil(t, err)
+			assert.Len(t, logs, test.expected)
+			assert.Equal(t, int32(4), logs[0].GetId())
+		})
+	}
+}
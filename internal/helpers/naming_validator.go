@@ -0,0 +1,133 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// namingTokenRegex mirrors the Readarr file name builder token syntax: {prefix Token Name:modifier suffix}.
+var namingTokenRegex = regexp.MustCompile(`(?i)^\{(?P<prefix>[- ._\[(]*)(?P<token>[a-z0-9]+(?:[- ._]+[a-z0-9]+)?)(?::(?P<modifier>[a-z0-9|]+))?(?P<suffix>[- ._)\]]*)\}$`)
+
+// namingSeparatorRegex matches the token separators, which are ignored by Readarr when comparing tokens.
+var namingSeparatorRegex = regexp.MustCompile(`[- ._]`)
+
+// namingIllegalCharacters cannot be part of a path.
+const namingIllegalCharacters = `<>:"|?*`
+
+// getNamingTokens returns the normalized Readarr naming tokens.
+func getNamingTokens() []string {
+	return []string{
+		// Author
+		"authorname", "authornamethe", "authorcleanname", "authorsortname", "authornamefirstcharacter", "authordisambiguation",
+		// Book
+		"booktitle", "booktitlethe", "bookcleantitle", "booktitlenosub", "booktitlethenosub", "bookdisambiguation",
+		"bookseries", "bookseriesposition", "bookseriestitle", "bookseriestitleposition",
+		"partnumber", "partcount",
+		// Release date
+		"releaseyear", "releaseyearfirst", "releasedate",
+		// Edition
+		"editiontitle", "editionyear",
+		// Quality
+		"qualityfull", "qualitytitle",
+		// Media info
+		"mediainfoaudiocodec", "mediainfoaudiochannels", "mediainfoaudiobitrate", "mediainfoaudiobitspersample", "mediainfoaudiosamplerate",
+		// Other
+		"releasegroup", "customformats", "originaltitle", "originalfilename",
+	}
+}
+
+// getNamingZeroPaddedTokens returns the tokens whose modifier is a zero padding.
+func getNamingZeroPaddedTokens() []string {
+	return []string{"partnumber", "partcount"}
+}
+
+// namingFormatValidator validates a Readarr naming format without calling the server.
+type namingFormatValidator struct{}
+
+// NamingFormatValidator returns a validator which checks Readarr naming tokens, braces and illegal path characters.
+func NamingFormatValidator() validator.String {
+	return namingFormatValidator{}
+}
+
+func (v namingFormatValidator) Description(_ context.Context) string {
+	return "value must be a valid Readarr naming format"
+}
+
+func (v namingFormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v namingFormatValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, problem := range ValidateNamingFormat(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Naming Format", problem)
+	}
+}
+
+// ValidateNamingFormat returns the list of problems found in a naming format.
+func ValidateNamingFormat(format string) []string {
+	var problems []string
+
+	start := -1
+
+	for i, c := range format {
+		switch {
+		case c == '{':
+			if start != -1 {
+				problems = append(problems, fmt.Sprintf("unbalanced braces: '{' at position %d opened inside another token", i))
+			}
+
+			start = i
+		case c == '}':
+			if start == -1 {
+				problems = append(problems, fmt.Sprintf("unbalanced braces: '}' at position %d without matching '{'", i))
+
+				continue
+			}
+
+			if problem := validateNamingToken(format[start : i+1]); problem != "" {
+				problems = append(problems, problem)
+			}
+
+			start = -1
+		case start != -1:
+			continue
+		case c < ' ' || strings.ContainsRune(namingIllegalCharacters, c):
+			problems = append(problems, fmt.Sprintf("illegal path character %q at position %d", c, i))
+		}
+	}
+
+	if start != -1 {
+		problems = append(problems, fmt.Sprintf("unbalanced braces: '{' at position %d is never closed", start))
+	}
+
+	return problems
+}
+
+// validateNamingToken validates a single token including its braces.
+func validateNamingToken(token string) string {
+	match := namingTokenRegex.FindStringSubmatch(token)
+	if match == nil {
+		return fmt.Sprintf("invalid token syntax %s", token)
+	}
+
+	name := strings.ToLower(namingSeparatorRegex.ReplaceAllString(match[namingTokenRegex.SubexpIndex("token")], ""))
+	if !slices.Contains(getNamingTokens(), name) {
+		return fmt.Sprintf("unknown token %s", token)
+	}
+
+	modifier := match[namingTokenRegex.SubexpIndex("modifier")]
+	if modifier != "" && slices.Contains(getNamingZeroPaddedTokens(), name) && strings.Trim(modifier, "0") != "" {
+		return fmt.Sprintf("invalid modifier %s for token %s, only zero padding is supported", modifier, token)
+	}
+
+	return ""
+}
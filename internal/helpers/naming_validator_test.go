@@ -0,0 +1,103 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNamingFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		format   string
+		expected []string
+	}{
+		"author": {
+			format: "{Author Name}",
+		},
+		"separator": {
+			format: "{Author_Name}",
+		},
+		"book": {
+			format: "{Book Title}/{Author Name} - {Book Title}{ (PartNumber:00)}",
+		},
+		"series": {
+			format: "{Book Series}/{Book SeriesPosition} - {Book Title} [{Quality Title}]",
+		},
+		"unknown": {
+			format:   "{Author Nme}",
+			expected: []string{"unknown token {Author Nme}"},
+		},
+		"modifier": {
+			format:   "{PartNumber:ab}",
+			expected: []string{"invalid modifier ab for token {PartNumber:ab}, only zero padding is supported"},
+		},
+		"syntax": {
+			format:   "{Author:Name:Full}",
+			expected: []string{"invalid token syntax {Author:Name:Full}"},
+		},
+		"unclosed": {
+			format:   "{Author Name",
+			expected: []string{"unbalanced braces: '{' at position 0 is never closed"},
+		},
+		"unopened": {
+			format:   "Author Name}",
+			expected: []string{"unbalanced braces: '}' at position 11 without matching '{'"},
+		},
+		"nested": {
+			format:   "{Author {Name}",
+			expected: []string{"unbalanced braces: '{' at position 8 opened inside another token", "unknown token {Name}"},
+		},
+		"illegal": {
+			format:   "{Author Name}: {Book Title}?",
+			expected: []string{"illegal path character ':' at position 13", "illegal path character '?' at position 27"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ValidateNamingFormat(test.format))
+		})
+	}
+}
+
+func TestNamingFormatValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"valid": {
+			value: types.StringValue("{Author Name}"),
+		},
+		"invalid": {
+			value:    types.StringValue("{Author Nme}"),
+			expected: true,
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := validator.StringResponse{}
+			NamingFormatValidator().ValidateString(context.TODO(), validator.StringRequest{Path: path.Root("format"), ConfigValue: test.value}, &resp)
+			assert.Equal(t, test.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
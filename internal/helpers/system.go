@@ -0,0 +1,61 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+// define constants for restart management.
+const (
+	RestartTimeout      = 10 * time.Minute
+	RestartPollInterval = 5 * time.Second
+)
+
+// ErrRestartTimeout is returned when the application does not come back before RestartTimeout.
+var ErrRestartTimeout = errors.New("application did not restart in time")
+
+// WaitRestart polls the system status until the application answers with a start time after the given one.
+// Errors are expected while the application is down, so they are retried until timeout.
+func WaitRestart(ctx context.Context, client *readarr.APIClient, previousStart time.Time, interval time.Duration) (*readarr.SystemResource, error) {
+	ctx, cancel := context.WithTimeout(ctx, RestartTimeout)
+	defer cancel()
+
+	var lastErr error
+
+	for {
+		status, _, err := client.SystemApi.GetSystemStatus(ctx).Execute()
+		if err == nil && status.GetStartTime().After(previousStart) {
+			return status, nil
+		}
+
+		lastErr = err
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %v", ErrRestartTimeout, lastErr)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// HealthErrors returns the messages of all health checks in error state.
+func HealthErrors(ctx context.Context, client *readarr.APIClient) ([]string, error) {
+	health, _, err := client.HealthApi.ListHealth(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+
+	for _, h := range health {
+		if h.GetType() == readarr.HEALTHCHECKRESULT_ERROR {
+			messages = append(messages, h.GetSource()+": "+h.GetMessage())
+		}
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitRestart(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"0.1.0","startTime":"2023-01-01T00:00:00Z"}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"0.2.0","startTime":"2023-01-02T00:00:00Z"}`))
+		}
+	}))
+	defer server.Close()
+
+	status, err := WaitRestart(context.TODO(), testAPIClient(server.URL), time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.2.0", status.GetVersion())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestHealthErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"source":"RootFolderCheck","type":"error","message":"Missing root folder"},{"source":"IndexerCheck","type":"warning","message":"No indexers"}]`))
+	}))
+	defer server.Close()
+
+	messages, err := HealthErrors(context.TODO(), testAPIClient(server.URL))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"RootFolderCheck: Missing root folder"}, messages)
+}
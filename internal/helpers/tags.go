@@ -0,0 +1,434 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TagNotFoundError is the error title for tag labels not matching any tag.
+const TagNotFoundError = "Tag Not Found"
+
+// ResourceTags handles the tags of a taggable resource during a single operation,
+// listing the Readarr tags at most once.
+type ResourceTags struct {
+	client        *readarr.APIClient
+	expected      types.Set
+	tagList       []*readarr.TagResource
+	defaults      []string
+	createMissing bool
+	listed        bool
+}
+
+// NewResourceTags returns the tag handler of a resource operation.
+// Expected are the planned or prior state tags, the default tags outside them are left out of state.
+func NewResourceTags(client *readarr.APIClient, createMissing bool, defaults []string, expected types.Set) *ResourceTags {
+	return &ResourceTags{
+		client:        client,
+		createMissing: createMissing,
+		defaults:      defaults,
+		expected:      expected,
+	}
+}
+
+// Request returns the tags to send to Readarr, the IDs of the given labels when configured, otherwise the given tags,
+// merged with the default tags. Missing tags are created if requested.
+func (t *ResourceTags) Request(ctx context.Context, labels types.Set, tags []*int32, diags *diag.Diagnostics) []*int32 {
+	if !labels.IsNull() && !labels.IsUnknown() {
+		tags = t.resolve(ctx, labels, diags)
+
+		var tempDiag diag.Diagnostics
+
+		t.expected, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tags)
+		diags.Append(tempDiag...)
+	}
+
+	if len(t.defaults) == 0 {
+		return tags
+	}
+
+	tagList := t.list(ctx, diags)
+	if tagList == nil {
+		return tags
+	}
+
+	ids, missing := DefaultTagIDs(tagList, t.defaults)
+
+	for _, label := range missing {
+		if isTagID(label) {
+			diags.AddError(TagNotFoundError, fmt.Sprintf("Default tag ID %s does not exist.", label))
+
+			continue
+		}
+
+		if !t.createMissing {
+			continue
+		}
+
+		if id := t.create(ctx, label, diags); id != nil {
+			ids = append(ids, id)
+		}
+	}
+
+	for _, id := range ids {
+		if !containsTag(tags, *id) {
+			tags = append(tags, id)
+		}
+	}
+
+	return tags
+}
+
+// Write sets tagsAll, when given, to the tags read from Readarr, tags to them without the default tags
+// that were not expected, so that explicit tags match their configuration, and labels to the labels of tags.
+func (t *ResourceTags) Write(ctx context.Context, tags, labels, tagsAll *types.Set, diags *diag.Diagnostics) {
+	if tagsAll != nil {
+		*tagsAll = *tags
+	}
+
+	var ids []*int32
+
+	diags.Append(tags.ElementsAs(ctx, &ids, true)...)
+
+	// No need to list tags for an untagged resource
+	if len(ids) == 0 {
+		*labels = TagLabels(ctx, nil, *tags, diags)
+
+		return
+	}
+
+	tagList := t.list(ctx, diags)
+	if tagList == nil {
+		return
+	}
+
+	// Unknown expected tags are the default tags planned to be created
+	if len(t.defaults) > 0 && !t.expected.IsUnknown() {
+		var keep []*int32
+
+		diags.Append(t.expected.ElementsAs(ctx, &keep, true)...)
+
+		defaults, _ := DefaultTagIDs(tagList, t.defaults)
+		result := make([]*int32, 0, len(ids))
+
+		for _, id := range ids {
+			if containsTag(defaults, *id) && !containsTag(keep, *id) {
+				continue
+			}
+
+			result = append(result, id)
+		}
+
+		var tempDiag diag.Diagnostics
+
+		*tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, result)
+		diags.Append(tempDiag...)
+	}
+
+	*labels = TagLabels(ctx, tagList, *tags, diags)
+}
+
+// resolve returns the IDs of the given tag labels, creating the missing tags if requested.
+func (t *ResourceTags) resolve(ctx context.Context, labels types.Set, diags *diag.Diagnostics) []*int32 {
+	var names []string
+
+	diags.Append(labels.ElementsAs(ctx, &names, false)...)
+
+	tagList := t.list(ctx, diags)
+	if tagList == nil {
+		return nil
+	}
+
+	resolved := make([]*int32, 0, len(names))
+
+	for _, name := range names {
+		if id := tagLabelID(tagList, name); id != nil {
+			resolved = append(resolved, id)
+
+			continue
+		}
+
+		if !t.createMissing {
+			diags.AddError(TagNotFoundError, fmt.Sprintf("Tag '%s' does not exist, create it with a tag resource or set provider `create_missing_tags`.", name))
+
+			continue
+		}
+
+		if id := t.create(ctx, name, diags); id != nil {
+			resolved = append(resolved, id)
+		}
+	}
+
+	return resolved
+}
+
+// list returns the Readarr tags, fetched on first use. It returns nil on error.
+func (t *ResourceTags) list(ctx context.Context, diags *diag.Diagnostics) []*readarr.TagResource {
+	if t.listed {
+		return t.tagList
+	}
+
+	response, _, err := t.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		diags.AddError(ClientError, ParseClientError(List, "tag", err))
+
+		return nil
+	}
+
+	t.tagList = response
+	if t.tagList == nil {
+		t.tagList = []*readarr.TagResource{}
+	}
+
+	t.listed = true
+
+	return t.tagList
+}
+
+// create creates a tag and adds it to the listed tags.
+func (t *ResourceTags) create(ctx context.Context, label string, diags *diag.Diagnostics) *int32 {
+	tag := readarr.NewTagResource()
+	tag.SetLabel(label)
+
+	created, _, err := t.client.TagApi.CreateTag(ctx).TagResource(*tag).Execute()
+	if err != nil {
+		diags.AddError(ClientError, ParseClientError(Create, "tag", err))
+
+		return nil
+	}
+
+	t.tagList = append(t.tagList, created)
+
+	return created.Id
+}
+
+// WriteTagLabels sets labels to the labels of the given tag IDs.
+func WriteTagLabels(ctx context.Context, client *readarr.APIClient, tags types.Set, labels *types.Set, diags *diag.Diagnostics) {
+	response, _, err := client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		diags.AddError(ClientError, ParseClientError(List, "tag", err))
+
+		return
+	}
+
+	*labels = TagLabels(ctx, response, tags, diags)
+}
+
+// TagLabels returns the labels of the given tag IDs from a tag list.
+func TagLabels(ctx context.Context, tagList []*readarr.TagResource, tags types.Set, diags *diag.Diagnostics) types.Set {
+	var ids []int64
+
+	diags.Append(tags.ElementsAs(ctx, &ids, true)...)
+
+	labels := make(map[int64]string, len(tagList))
+	for _, t := range tagList {
+		labels[int64(t.GetId())] = t.GetLabel()
+	}
+
+	names := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		if label, ok := labels[id]; ok {
+			names = append(names, label)
+		}
+	}
+
+	sort.Strings(names)
+
+	result, tempDiag := types.SetValueFrom(ctx, types.StringType, names)
+	diags.Append(tempDiag...)
+
+	return result
+}
+
+// DefaultTagIDs returns the IDs of the default tags, given as labels or IDs, and the defaults not matching any tag.
+func DefaultTagIDs(tagList []*readarr.TagResource, defaults []string) ([]*int32, []string) {
+	ids := make([]*int32, 0, len(defaults))
+	missing := make([]string, 0)
+
+	for _, d := range defaults {
+		if id := tagID(tagList, d); id != nil {
+			ids = append(ids, id)
+
+			continue
+		}
+
+		missing = append(missing, d)
+	}
+
+	return ids, missing
+}
+
+// isTagID reports whether a default tag is given as an ID, so that it is never created as a label.
+func isTagID(value string) bool {
+	_, err := strconv.Atoi(value)
+
+	return err == nil
+}
+
+// tagID returns the ID of the tag matching a label or an ID.
+// Labels are compared ignoring case, as Readarr stores them in lowercase.
+func tagID(tagList []*readarr.TagResource, value string) *int32 {
+	for _, t := range tagList {
+		if strings.EqualFold(t.GetLabel(), value) || strconv.Itoa(int(t.GetId())) == value {
+			return t.Id
+		}
+	}
+
+	return nil
+}
+
+// ModifyPlanTags plans tags_all as the planned tags merged with the default tags,
+// which are also the planned tags when the resource has neither tags nor tag_labels configured.
+func ModifyPlanTags(ctx context.Context, client *readarr.APIClient, createMissing bool, defaults []string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags, configTags, configLabels types.Set
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tag_labels"), &configLabels)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(defaults) == 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tags)...)
+
+		return
+	}
+
+	// Default tags cannot be resolved with an unconfigured provider
+	if client == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.Int64Type))...)
+
+		return
+	}
+
+	response, _, err := client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(ClientError, ParseClientError(List, "tag", err))
+
+		return
+	}
+
+	ids, missing := DefaultTagIDs(response, defaults)
+	for _, d := range missing {
+		if isTagID(d) {
+			resp.Diagnostics.AddError(TagNotFoundError, fmt.Sprintf("Default tag ID %s does not exist.", d))
+
+			return
+		}
+	}
+
+	if len(missing) > 0 && !createMissing {
+		resp.Diagnostics.AddError(TagNotFoundError, fmt.Sprintf("Default tags %s do not exist, create them or set provider `create_missing_tags`.", strings.Join(missing, ", ")))
+
+		return
+	}
+
+	explicit := !configTags.IsNull() || !configLabels.IsNull()
+
+	// Missing tags are created on apply
+	if len(missing) > 0 {
+		if !explicit {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags"), types.SetUnknown(types.Int64Type))...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tag_labels"), types.SetUnknown(types.StringType))...)
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.Int64Type))...)
+
+		return
+	}
+
+	if !explicit {
+		var tempDiag diag.Diagnostics
+
+		tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, ids)
+		resp.Diagnostics.Append(tempDiag...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags"), tags)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tag_labels"), TagLabels(ctx, response, tags, &resp.Diagnostics))...)
+	}
+
+	planned, known := plannedTagIDs(ctx, response, tags, configLabels, &resp.Diagnostics)
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.Int64Type))...)
+
+		return
+	}
+
+	for _, id := range ids {
+		if !containsTag(planned, *id) {
+			planned = append(planned, id)
+		}
+	}
+
+	all, tempDiag := types.SetValueFrom(ctx, types.Int64Type, planned)
+	resp.Diagnostics.Append(tempDiag...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), all)...)
+}
+
+// plannedTagIDs returns the planned tag IDs, resolving the configured labels when tags are not known yet,
+// and whether they are known.
+func plannedTagIDs(ctx context.Context, tagList []*readarr.TagResource, tags, labels types.Set, diags *diag.Diagnostics) ([]*int32, bool) {
+	var ids []*int32
+
+	if !tags.IsUnknown() {
+		diags.Append(tags.ElementsAs(ctx, &ids, true)...)
+
+		return ids, true
+	}
+
+	if labels.IsNull() || labels.IsUnknown() {
+		return nil, false
+	}
+
+	var names []string
+
+	diags.Append(labels.ElementsAs(ctx, &names, true)...)
+
+	for _, name := range names {
+		id := tagLabelID(tagList, name)
+		// Tags created on apply
+		if id == nil {
+			return nil, false
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, true
+}
+
+// tagLabelID returns the ID of the tag matching a label.
+func tagLabelID(tagList []*readarr.TagResource, label string) *int32 {
+	for _, t := range tagList {
+		if t.GetLabel() == label {
+			return t.Id
+		}
+	}
+
+	return nil
+}
+
+func containsTag(tags []*int32, id int32) bool {
+	for _, t := range tags {
+		if t != nil && *t == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,279 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTagsRequest(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`{"id":3,"label":"new"}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`[{"id":1,"label":"fiction"},{"id":2,"label":"travel"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	one, two, three, four := int32(1), int32(2), int32(3), int32(4)
+
+	tests := map[string]struct {
+		labels        types.Set
+		defaults      []string
+		createMissing bool
+		expected      []*int32
+		err           bool
+	}{
+		"null": {
+			labels:   types.SetNull(types.StringType),
+			expected: []*int32{&four},
+		},
+		"unknown": {
+			labels:   types.SetUnknown(types.StringType),
+			expected: []*int32{&four},
+		},
+		"existing": {
+			labels:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction"), types.StringValue("travel")}),
+			expected: []*int32{&one, &two},
+		},
+		"missing": {
+			labels:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("new")}),
+			expected: []*int32{},
+			err:      true,
+		},
+		"created": {
+			labels:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction"), types.StringValue("new")}),
+			createMissing: true,
+			expected:      []*int32{&one, &three},
+		},
+		"defaults": {
+			labels:   types.SetNull(types.StringType),
+			defaults: []string{"travel", "4"},
+			expected: []*int32{&four, &two},
+			err:      true,
+		},
+		"created defaults": {
+			labels:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction")}),
+			defaults:      []string{"fiction", "new"},
+			createMissing: true,
+			expected:      []*int32{&one, &three},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			tags := NewResourceTags(testAPIClient(server.URL), test.createMissing, test.defaults, types.SetNull(types.Int64Type))
+			result := tags.Request(context.TODO(), test.labels, []*int32{&four}, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestWriteTagLabels(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"label":"fiction"},{"id":2,"label":"travel"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		tags     types.Set
+		expected types.Set
+	}{
+		"empty": {
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{}),
+			expected: types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+		"unknown": {
+			tags:     types.SetUnknown(types.Int64Type),
+			expected: types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+		"labels": {
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2), types.Int64Value(1)}),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction"), types.StringValue("travel")}),
+		},
+		"deleted": {
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(5)}),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction")}),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			labels := types.SetNull(types.StringType)
+			WriteTagLabels(context.TODO(), testAPIClient(server.URL), test.tags, &labels, &diags)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.expected, labels)
+		})
+	}
+}
+
+func TestDefaultTagIDs(t *testing.T) {
+	t.Parallel()
+
+	fiction := readarr.NewTagResource()
+	fiction.SetId(1)
+	fiction.SetLabel("fiction")
+
+	travel := readarr.NewTagResource()
+	travel.SetId(2)
+	travel.SetLabel("travel")
+
+	ids, missing := DefaultTagIDs([]*readarr.TagResource{fiction, travel}, []string{"fiction", "2", "5", "new"})
+	assert.Equal(t, []*int32{fiction.Id, travel.Id}, ids)
+	assert.Equal(t, []string{"5", "new"}, missing)
+
+	ids, missing = DefaultTagIDs([]*readarr.TagResource{fiction, travel}, []string{"Fiction"})
+	assert.Equal(t, []*int32{fiction.Id}, ids)
+	assert.Empty(t, missing)
+}
+
+func TestResourceTagsWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected types.Set
+		remote   types.Set
+		tags     types.Set
+		labels   types.Set
+		lists    int32
+	}{
+		"removed": {
+			remote:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2), types.Int64Value(3)}),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)}),
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)}),
+			labels:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("new")}),
+			lists:    1,
+		},
+		"kept": {
+			remote:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2), types.Int64Value(3)}),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(3)}),
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(3)}),
+			labels:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction"), types.StringValue("new")}),
+			lists:    1,
+		},
+		"unknown": {
+			remote:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2), types.Int64Value(3)}),
+			expected: types.SetUnknown(types.Int64Type),
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2), types.Int64Value(3)}),
+			labels:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction"), types.StringValue("new"), types.StringValue("travel")}),
+			lists:    1,
+		},
+		"untagged": {
+			remote:   types.SetValueMust(types.Int64Type, []attr.Value{}),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{}),
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{}),
+			labels:   types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var lists int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				atomic.AddInt32(&lists, 1)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`[{"id":1,"label":"fiction"},{"id":2,"label":"travel"},{"id":3,"label":"new"}]`))
+			}))
+			t.Cleanup(server.Close)
+
+			var diags diag.Diagnostics
+
+			tags := test.remote
+			labels := types.SetUnknown(types.StringType)
+			all := types.SetUnknown(types.Int64Type)
+
+			NewResourceTags(testAPIClient(server.URL), false, []string{"fiction", "2"}, test.expected).Write(context.TODO(), &tags, &labels, &all, &diags)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.tags, tags)
+			assert.Equal(t, test.labels, labels)
+			assert.Equal(t, test.remote, all)
+			assert.Equal(t, test.lists, lists)
+		})
+	}
+}
+
+func TestPlannedTagIDs(t *testing.T) {
+	t.Parallel()
+
+	fiction := readarr.NewTagResource()
+	fiction.SetId(1)
+	fiction.SetLabel("fiction")
+
+	tagList := []*readarr.TagResource{fiction}
+	one, two := int32(1), int32(2)
+
+	tests := map[string]struct {
+		tags     types.Set
+		labels   types.Set
+		expected []*int32
+		known    bool
+	}{
+		"tags": {
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2)}),
+			labels:   types.SetNull(types.StringType),
+			expected: []*int32{&two},
+			known:    true,
+		},
+		"labels": {
+			tags:     types.SetUnknown(types.Int64Type),
+			labels:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction")}),
+			expected: []*int32{&one},
+			known:    true,
+		},
+		"created labels": {
+			tags:   types.SetUnknown(types.Int64Type),
+			labels: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("fiction"), types.StringValue("new")}),
+		},
+		"unknown": {
+			tags:   types.SetUnknown(types.Int64Type),
+			labels: types.SetNull(types.StringType),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			ids, known := plannedTagIDs(context.TODO(), tagList, test.tags, test.labels, &diags)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.known, known)
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
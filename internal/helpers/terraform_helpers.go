@@ -3,14 +3,21 @@ package helpers
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/readarr-go/readarr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// providerName is the provider type name, prefix of its resource type names.
+const providerName = "readarr"
+
 // ImportStatePassthroughIntID is a helper function to set the import
 // identifier to a given state attribute path. The attribute must accept a
 // int value.
@@ -35,24 +42,163 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
 
+// ImportLookup resolves a name into an ID, returning false if nothing matches.
+type ImportLookup func(ctx context.Context, name string) (int64, bool, error)
+
+// ImportStatePassthroughIntIDOrName works as ImportStatePassthroughIntID, but it also
+// accepts an import identifier with format `name:<value>` or any non numeric value,
+// resolved into the ID by the lookup function.
+func ImportStatePassthroughIntIDOrName(ctx context.Context, resourceName string, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse, lookup ImportLookup) {
+	name := ImportName(req.ID)
+	if name == "" {
+		ImportStatePassthroughIntID(ctx, attrPath, req, resp)
+
+		return
+	}
+
+	id, found, err := lookup(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError(ClientError, ParseClientError(Read, resourceName, err))
+
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError(
+			UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected import identifier with format: ID, name:NAME or NAME. No %s found with name: %s", resourceName, name),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
+}
+
+// ImportName returns the name from an import identifier, empty for numeric IDs.
+func ImportName(id string) string {
+	if name, found := strings.CutPrefix(id, "name:"); found {
+		return name
+	}
+
+	if _, err := strconv.Atoi(id); err == nil {
+		return ""
+	}
+
+	return id
+}
+
+// CheckImplementation adds an error if the implementation or config contract of a remote object
+// do not match the expected ones of a typed resource.
+func CheckImplementation(resourceName, implementation, configContract, expectedImplementation, expectedConfigContract string, diags *diag.Diagnostics) {
+	if strings.EqualFold(implementation, expectedImplementation) && strings.EqualFold(configContract, expectedConfigContract) {
+		return
+	}
+
+	diags.AddError(
+		ImplementationMismatchError,
+		fmt.Sprintf("Resource %s expects implementation %s with config contract %s, got: %s with config contract %s. Use the matching resource type or the generic one.", resourceName, expectedImplementation, expectedConfigContract, implementation, configContract),
+	)
+}
+
+// CreateOrAdopt creates a remote object, or, if adopt is set, updates instead the existing one that lookup finds by name.
+func CreateOrAdopt[T any](ctx context.Context, adopt bool, name string, lookup ImportLookup, create func() (*T, *http.Response, error), update func(id int32) (*T, *http.Response, error)) (*T, error) {
+	if adopt {
+		id, found, err := lookup(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			response, _, err := update(int32(id))
+
+			return response, err
+		}
+	}
+
+	response, _, err := create()
+
+	return response, err
+}
+
+// GenericStateMover returns the state mover from the sourceName generic resource of this provider to a typed resource.
+// The source state is read into a T model, which convert turns into the typed resource model,
+// once its implementation and config contract are checked against the typed resource ones.
+func GenericStateMover[T any](ctx context.Context, source resource.Resource, sourceName, resourceName, implementation, configContract string, convert func(*T) interface{}) resource.StateMover {
+	sourceSchema := resource.SchemaResponse{}
+	source.Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return resource.StateMover{
+		SourceSchema: &sourceSchema.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			// Other source resources, also same named ones from other providers, are left to the remaining state movers
+			if req.SourceTypeName != providerName+"_"+sourceName || !strings.HasSuffix(req.SourceProviderAddress, "/"+providerName) || req.SourceState == nil {
+				return
+			}
+
+			var (
+				model                                      T
+				sourceImplementation, sourceConfigContract types.String
+				tagsAll                                    types.Set
+			)
+
+			resp.Diagnostics.Append(req.SourceState.Get(ctx, &model)...)
+			resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("implementation"), &sourceImplementation)...)
+			resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("config_contract"), &sourceConfigContract)...)
+			resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			CheckImplementation(resourceName, sourceImplementation.ValueString(), sourceConfigContract.ValueString(), implementation, configContract, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, convert(&model))...)
+			// tags_all is only part of the resource models, not of the generic ones converted into typed ones
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+		},
+	}
+}
+
+// ProviderData contains the client and the provider wide options shared with resources.
+type ProviderData struct {
+	Client            *readarr.APIClient
+	DefaultTags       []string
+	CheckPaths        bool
+	CreateMissingTags bool
+}
+
 // ResourceConfigure is a helper function to set the client for a specific resource.
-func ResourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *readarr.APIClient {
+func ResourceConfigure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *readarr.APIClient {
+	if data := ResourceProviderData(ctx, req, resp); data != nil {
+		return data.Client
+	}
+
+	return nil
+}
+
+// ResourceProviderData is a helper function to get the provider data for resources needing the provider options.
+func ResourceProviderData(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *ProviderData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return nil
 	}
 
-	client, ok := req.ProviderData.(*readarr.APIClient)
+	data, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			UnexpectedResourceConfigureType,
-			fmt.Sprintf("Expected *readarr.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return nil
 	}
 
-	return client
+	return data
 }
 
 // DataSourceConfigure is a helper function to set the client for a specific data source.
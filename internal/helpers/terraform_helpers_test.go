@@ -2,12 +2,18 @@ package helpers
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,17 +60,17 @@ func TestResourceConfigure(t *testing.T) {
 
 	var diags diag.Diagnostics
 
-	diags.AddError("Unexpected Resource Configure Type", "Expected *readarr.APIClient, got: string. Please report this issue to the provider developers.")
+	diags.AddError("Unexpected Resource Configure Type", "Expected *helpers.ProviderData, got: string. Please report this issue to the provider developers.")
 
 	tests := map[string]struct {
 		expected    any
 		errorString diag.Diagnostics
 	}{
 		"working": {
-			expected: readarr.NewAPIClient(readarr.NewConfiguration()),
+			expected: &ProviderData{Client: readarr.NewAPIClient(readarr.NewConfiguration())},
 		},
 		"nil": {
-			expected: (*readarr.APIClient)(nil),
+			expected: (*ProviderData)(nil),
 		},
 		"error": {
 			expected:    "abc",
@@ -78,11 +84,252 @@ func TestResourceConfigure(t *testing.T) {
 
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			client := ResourceConfigure(context.TODO(), req, &resp)
+			data := ResourceProviderData(context.TODO(), req, &resp)
 			if !resp.Diagnostics.HasError() {
-				assert.Equal(t, test.expected, client)
+				assert.Equal(t, test.expected, data)
 			}
 			assert.Equal(t, test.errorString, resp.Diagnostics)
 		})
 	}
 }
+
+func TestImportName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id       string
+		expected string
+	}{
+		"numeric": {
+			id:       "12",
+			expected: "",
+		},
+		"prefixed": {
+			id:       "name:Transmission",
+			expected: "Transmission",
+		},
+		"prefixed numeric": {
+			id:       "name:12",
+			expected: "12",
+		},
+		"bare": {
+			id:       "Transmission",
+			expected: "Transmission",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ImportName(test.id))
+		})
+	}
+}
+
+func TestCheckImplementation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		implementation string
+		configContract string
+		err            bool
+	}{
+		"matching": {
+			implementation: "QBittorrent",
+			configContract: "QBittorrentSettings",
+		},
+		"case": {
+			implementation: "qbittorrent",
+			configContract: "qbittorrentsettings",
+		},
+		"implementation": {
+			implementation: "Transmission",
+			configContract: "QBittorrentSettings",
+			err:            true,
+		},
+		"config contract": {
+			implementation: "QBittorrent",
+			configContract: "TransmissionSettings",
+			err:            true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			CheckImplementation("download_client_qbittorrent", test.implementation, test.configContract, "QBittorrent", "QBittorrentSettings", &diags)
+			assert.Equal(t, test.err, diags.HasError())
+		})
+	}
+}
+
+func TestCreateOrAdopt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		adopt    bool
+		name     string
+		expected int32
+	}{
+		"create": {
+			name:     "existing",
+			expected: 0,
+		},
+		"adopt": {
+			adopt:    true,
+			name:     "existing",
+			expected: 5,
+		},
+		"adopt missing": {
+			adopt:    true,
+			name:     "new",
+			expected: 0,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := func(_ context.Context, name string) (int64, bool, error) {
+				return 5, name == "existing", nil
+			}
+
+			response, err := CreateOrAdopt(context.TODO(), test.adopt, test.name, lookup,
+				func() (*int32, *http.Response, error) {
+					id := int32(0)
+
+					return &id, nil, nil
+				},
+				func(id int32) (*int32, *http.Response, error) {
+					return &id, nil, nil
+				},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, *response)
+		})
+	}
+}
+
+// testGenericResource is a minimal generic resource for the state mover tests.
+type testGenericResource struct{}
+
+type testGenericModel struct {
+	TagsAll        types.Set    `tfsdk:"tags_all"`
+	Name           types.String `tfsdk:"name"`
+	Implementation types.String `tfsdk:"implementation"`
+	ConfigContract types.String `tfsdk:"config_contract"`
+}
+
+type testTypedModel struct {
+	TagsAll types.Set    `tfsdk:"tags_all"`
+	Name    types.String `tfsdk:"name"`
+}
+
+func (r *testGenericResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "readarr_test"
+}
+
+func (r *testGenericResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags_all":        schema.SetAttribute{Computed: true, ElementType: types.Int64Type},
+			"name":            schema.StringAttribute{Required: true},
+			"implementation":  schema.StringAttribute{Required: true},
+			"config_contract": schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func (r *testGenericResource) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+}
+
+func (r *testGenericResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r *testGenericResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *testGenericResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func TestGenericStateMover(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typeName       string
+		address        string
+		implementation string
+		moved          bool
+		err            bool
+	}{
+		"moved": {
+			typeName:       "readarr_test",
+			address:        "registry.terraform.io/devopsarr/readarr",
+			implementation: "Transmission",
+			moved:          true,
+		},
+		"other resource": {
+			typeName:       "readarr_other",
+			address:        "registry.terraform.io/devopsarr/readarr",
+			implementation: "Transmission",
+		},
+		"other provider": {
+			typeName:       "readarr_test",
+			address:        "registry.terraform.io/devopsarr/sonarr",
+			implementation: "Transmission",
+		},
+		"implementation": {
+			typeName:       "readarr_test",
+			address:        "registry.terraform.io/devopsarr/readarr",
+			implementation: "Deluge",
+			err:            true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.TODO()
+			mover := GenericStateMover(ctx, &testGenericResource{}, "test", "test_transmission", "Transmission", "TransmissionSettings", func(model *testGenericModel) interface{} {
+				return &testTypedModel{TagsAll: types.SetNull(types.Int64Type), Name: model.Name}
+			})
+
+			tagsAll := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)})
+			source := tfsdk.State{Schema: *mover.SourceSchema}
+			source.Raw = tftypes.NewValue(source.Schema.Type().TerraformType(ctx), nil)
+			assert.False(t, source.Set(ctx, &testGenericModel{
+				TagsAll:        tagsAll,
+				Name:           types.StringValue("test"),
+				Implementation: types.StringValue(test.implementation),
+				ConfigContract: types.StringValue("TransmissionSettings"),
+			}).HasError())
+
+			targetSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"tags_all": schema.SetAttribute{Computed: true, ElementType: types.Int64Type},
+					"name":     schema.StringAttribute{Required: true},
+				},
+			}
+			resp := resource.MoveStateResponse{TargetState: tfsdk.State{Schema: targetSchema, Raw: tftypes.NewValue(targetSchema.Type().TerraformType(ctx), nil)}}
+			mover.StateMover(ctx, resource.MoveStateRequest{SourceTypeName: test.typeName, SourceProviderAddress: test.address, SourceState: &source}, &resp)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+			assert.Equal(t, test.moved, !resp.TargetState.Raw.IsNull())
+
+			if test.moved {
+				var target testTypedModel
+
+				assert.False(t, resp.TargetState.Get(ctx, &target).HasError())
+				assert.Equal(t, testTypedModel{TagsAll: tagsAll, Name: types.StringValue("test")}, target)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+// define constants for wanted management.
+const (
+	WantedMissingPath = "/api/v1/wanted/missing"
+	WantedCutoffPath  = "/api/v1/wanted/cutoff"
+	WantedPageSize    = 250
+)
+
+// WantedBooks pages through the given wanted endpoint and returns all the books with the total count.
+// The SDK does not expose paging and monitored parameters, so the API is called directly.
+func WantedBooks(ctx context.Context, client *readarr.APIClient, urlPath string, monitored bool, pageSize int) ([]*readarr.BookResource, int, error) {
+	var books []*readarr.BookResource
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("pageSize", strconv.Itoa(pageSize))
+		query.Set("sortKey", "releaseDate")
+		query.Set("sortDirection", "descending")
+		query.Set("includeAuthor", "true")
+		query.Set("monitored", strconv.FormatBool(monitored))
+
+		response := readarr.NewBookResourcePagingResource()
+		if err := APIJSON(ctx, client, http.MethodGet, urlPath, query, nil, response); err != nil {
+			return nil, 0, err
+		}
+
+		books = append(books, response.GetRecords()...)
+
+		if len(response.GetRecords()) == 0 || len(books) >= int(response.GetTotalRecords()) {
+			return books, int(response.GetTotalRecords()), nil
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWantedBooks(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("monitored") != "false" || r.URL.Query().Get("pageSize") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"totalRecords":3,"records":[{"id":1},{"id":2}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"totalRecords":3,"records":[{"id":3}]}`))
+		default:
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"totalRecords":3,"page":%s,"records":[]}`, r.URL.Query().Get("page"))))
+		}
+	}))
+	defer server.Close()
+
+	books, total, err := WantedBooks(context.TODO(), testAPIClient(server.URL), WantedMissingPath, false, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, total)
+	assert.Len(t, books, 3)
+	assert.Equal(t, int32(3), books[2].GetId())
+}
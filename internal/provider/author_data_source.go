@@ -72,6 +72,11 @@ func (d *AuthorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"genres": schema.SetAttribute{
 				MarkdownDescription: "List genres.",
 				Computed:            true,
@@ -105,6 +110,7 @@ func (d *AuthorDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	data.find(ctx, data.ForeignAuthorID.ValueString(), response, &resp.Diagnostics)
+	helpers.WriteTagLabels(ctx, d.client, data.Tags, &data.TagLabels, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+authorDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -24,6 +24,7 @@ const authorResourceName = "author"
 var (
 	_ resource.Resource                = &AuthorResource{}
 	_ resource.ResourceWithImportState = &AuthorResource{}
+	_ resource.ResourceWithModifyPlan  = &AuthorResource{}
 )
 
 func NewAuthorResource() resource.Resource {
@@ -32,13 +33,16 @@ func NewAuthorResource() resource.Resource {
 
 // AuthorResource defines the author implementation.
 type AuthorResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // Author describes the author data model.
 type Author struct {
 	Genres           types.Set    `tfsdk:"genres"`
 	Tags             types.Set    `tfsdk:"tags"`
+	TagLabels        types.Set    `tfsdk:"tag_labels"`
 	AuthorName       types.String `tfsdk:"author_name"`
 	ForeignAuthorID  types.String `tfsdk:"foreign_author_id"`
 	Status           types.String `tfsdk:"status"`
@@ -59,11 +63,28 @@ type Author struct {
 	// Ratings        types.Object `tfsdk:"ratings"`
 }
 
+// ManagedAuthor describes the author resource data model, the Author fields plus the resource only attributes.
+type ManagedAuthor struct {
+	Genres           types.Set    `tfsdk:"genres"`
+	Tags             types.Set    `tfsdk:"tags"`
+	TagLabels        types.Set    `tfsdk:"tag_labels"`
+	TagsAll          types.Set    `tfsdk:"tags_all"`
+	AuthorName       types.String `tfsdk:"author_name"`
+	ForeignAuthorID  types.String `tfsdk:"foreign_author_id"`
+	Status           types.String `tfsdk:"status"`
+	Path             types.String `tfsdk:"path"`
+	Overview         types.String `tfsdk:"overview"`
+	ID               types.Int64  `tfsdk:"id"`
+	QualityProfileID types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+}
+
 func (a Author) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"genres":             types.SetType{}.WithElementType(types.StringType),
 			"tags":               types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":         types.SetType{}.WithElementType(types.StringType),
 			"author_name":        types.StringType,
 			"foreign_author_id":  types.StringType,
 			"status":             types.StringType,
@@ -124,6 +145,8 @@ func (r *AuthorResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"genres": schema.SetAttribute{
 				MarkdownDescription: "List genres.",
 				Computed:            true,
@@ -134,14 +157,20 @@ func (r *AuthorResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 }
 
 func (r *AuthorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *AuthorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *AuthorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var author *Author
+	var author *ManagedAuthor
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &author)...)
 
@@ -150,7 +179,14 @@ func (r *AuthorResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create new Author
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, author.Tags)
 	request := author.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, author.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// TODO: can parametrize AddAuthorOptions
 	options := readarr.NewAddAuthorOptions()
 	options.SetMonitor(readarr.MONITORTYPES_ALL)
@@ -166,12 +202,13 @@ func (r *AuthorResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created author: "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	author.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &author.Tags, &author.TagLabels, &author.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &author)...)
 }
 
 func (r *AuthorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var author *Author
+	var author *ManagedAuthor
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &author)...)
 
@@ -189,13 +226,15 @@ func (r *AuthorResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	tflog.Trace(ctx, "read "+authorResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, author.Tags)
 	author.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &author.Tags, &author.TagLabels, &author.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &author)...)
 }
 
 func (r *AuthorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var author *Author
+	var author *ManagedAuthor
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &author)...)
 
@@ -204,7 +243,13 @@ func (r *AuthorResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update Author
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, author.Tags)
 	request := author.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, author.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.AuthorApi.UpdateAuthor(ctx, fmt.Sprint(request.GetId())).AuthorResource(*request).Execute()
 	if err != nil {
@@ -216,6 +261,7 @@ func (r *AuthorResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated "+authorResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	author.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &author.Tags, &author.TagLabels, &author.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &author)...)
 }
 
@@ -241,7 +287,7 @@ func (r *AuthorResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *AuthorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, authorResourceName, path.Root("id"), req, resp, authorLookup(r.client))
 	tflog.Trace(ctx, "imported "+authorResourceName+": "+req.ID)
 }
 
@@ -277,3 +323,60 @@ func (a *Author) read(ctx context.Context, diags *diag.Diagnostics) *readarr.Aut
 
 	return author
 }
+
+func (a ManagedAuthor) toAuthor() *Author {
+	return &Author{
+		Genres:           a.Genres,
+		Tags:             a.Tags,
+		TagLabels:        a.TagLabels,
+		AuthorName:       a.AuthorName,
+		ForeignAuthorID:  a.ForeignAuthorID,
+		Status:           a.Status,
+		Path:             a.Path,
+		Overview:         a.Overview,
+		ID:               a.ID,
+		QualityProfileID: a.QualityProfileID,
+		Monitored:        a.Monitored,
+	}
+}
+
+func (a *ManagedAuthor) fromAuthor(author *Author) {
+	a.Genres = author.Genres
+	a.Tags = author.Tags
+	a.TagLabels = author.TagLabels
+	a.AuthorName = author.AuthorName
+	a.ForeignAuthorID = author.ForeignAuthorID
+	a.Status = author.Status
+	a.Path = author.Path
+	a.Overview = author.Overview
+	a.ID = author.ID
+	a.QualityProfileID = author.QualityProfileID
+	a.Monitored = author.Monitored
+}
+
+func (a *ManagedAuthor) write(ctx context.Context, author *readarr.AuthorResource, diags *diag.Diagnostics) {
+	genericAuthor := a.toAuthor()
+	genericAuthor.write(ctx, author, diags)
+	a.fromAuthor(genericAuthor)
+}
+
+func (a *ManagedAuthor) read(ctx context.Context, diags *diag.Diagnostics) *readarr.AuthorResource {
+	return a.toAuthor().read(ctx, diags)
+}
+
+func authorLookup(client *readarr.APIClient) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.AuthorApi.ListAuthor(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetAuthorName() == name {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
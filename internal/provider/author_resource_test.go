@@ -50,6 +50,13 @@ func TestAccAuthorResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "readarr_author.test",
+				ImportState:       true,
+				ImportStateId:     "name:J.R.R. Tolkien",
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -87,6 +87,11 @@ func (d *AuthorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"genres": schema.SetAttribute{
 							MarkdownDescription: "List genres.",
 							Computed:            true,
@@ -114,11 +119,20 @@ func (d *AuthorsDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+authorsDataSourceName)
 	// Map response body to resource schema attribute
 	authors := make([]Author, len(response))
 	for i, m := range response {
 		authors[i].write(ctx, m, &resp.Diagnostics)
+		authors[i].TagLabels = helpers.TagLabels(ctx, tagList, authors[i].Tags, &resp.Diagnostics)
 	}
 
 	authorList, diags := types.SetValueFrom(ctx, Author{}.getType(), authors)
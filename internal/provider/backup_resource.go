@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	backupResourceName     = "backup"
+	backupCommand          = "Backup"
+	backupOutputPermission = 0o755
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BackupResource{}
+	_ resource.ResourceWithImportState = &BackupResource{}
+)
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+// BackupResource defines the backup implementation.
+type BackupResource struct {
+	client *readarr.APIClient
+}
+
+// Backup describes the backup data model.
+type Backup struct {
+	Name         types.String `tfsdk:"name"`
+	Path         types.String `tfsdk:"path"`
+	Type         types.String `tfsdk:"type"`
+	Time         types.String `tfsdk:"time"`
+	OutputPath   types.String `tfsdk:"output_path"`
+	OutputSHA256 types.String `tfsdk:"output_sha256"`
+	OutputMD5    types.String `tfsdk:"output_md5"`
+	ID           types.Int64  `tfsdk:"id"`
+	Size         types.Int64  `tfsdk:"size"`
+}
+
+func (r *BackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupResourceName
+}
+
+func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Backup resource.\nIt triggers a manual backup and optionally downloads the archive locally. The local archive is kept on destroy, while the backup is removed from Readarr.\nFor more information refer to [Backup](https://wiki.servarr.com/readarr/system#backup) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Backup ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "Local path where the backup archive is downloaded.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA256 checksum of the downloaded archive.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output_md5": schema.StringAttribute{
+				MarkdownDescription: "MD5 checksum of the downloaded archive.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Backup name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Backup path relative to the Readarr URL.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Backup type.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Backup time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Backup size in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run backup command and wait for completion
+	if _, err := helpers.ExecuteCommand(ctx, r.client, backupCommand, nil); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	// Retrieve the newest manual backup
+	list, _, err := r.client.BackupApi.ListSystemBackup(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, backupResourceName, err))
+
+		return
+	}
+
+	response := latestManualBackup(list)
+	if response == nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseNotFoundError(backupResourceName, "type", string(readarr.BACKUPTYPE_MANUAL)))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+backupResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	backup.write(response)
+	backup.download(ctx, r.client, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get backup current value
+	list, _, err := r.client.BackupApi.ListSystemBackup(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupResourceName, err))
+
+		return
+	}
+
+	for _, b := range list {
+		if int64(b.GetId()) == backup.ID.ValueInt64() {
+			tflog.Trace(ctx, "read "+backupResourceName+": "+strconv.Itoa(int(b.GetId())))
+			// Map response body to resource schema attribute
+			backup.write(b)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+
+			return
+		}
+	}
+
+	// Backups are pruned by retention, in that case a new one must be created
+	tflog.Trace(ctx, "removed "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Backup cannot be updated, every change requires a replacement
+	tflog.Trace(ctx, "updated "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete backup current value
+	_, err := r.client.BackupApi.DeleteSystemBackup(ctx, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, backupResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+backupResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *BackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+backupResourceName+": "+req.ID)
+}
+
+// latestManualBackup returns the most recent manual backup, if any.
+func latestManualBackup(backups []*readarr.BackupResource) *readarr.BackupResource {
+	var latest *readarr.BackupResource
+
+	for _, b := range backups {
+		if b.GetType() != readarr.BACKUPTYPE_MANUAL {
+			continue
+		}
+
+		if latest == nil || b.GetTime().After(latest.GetTime()) {
+			latest = b
+		}
+	}
+
+	return latest
+}
+
+func (b *Backup) write(backup *readarr.BackupResource) {
+	b.ID = types.Int64Value(int64(backup.GetId()))
+	b.Name = types.StringValue(backup.GetName())
+	b.Path = types.StringValue(backup.GetPath())
+	b.Type = types.StringValue(string(backup.GetType()))
+	b.Size = types.Int64Value(backup.GetSize())
+	b.Time = types.StringValue(backup.GetTime().String())
+}
+
+// download saves the backup archive into output path and computes its checksums.
+func (b *Backup) download(ctx context.Context, client *readarr.APIClient, diags *diag.Diagnostics) {
+	b.OutputSHA256 = types.StringNull()
+	b.OutputMD5 = types.StringNull()
+
+	if b.OutputPath.IsNull() {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(b.OutputPath.ValueString()), backupOutputPermission); err != nil {
+		diags.AddError(helpers.ResourceError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	file, err := os.Create(b.OutputPath.ValueString())
+	if err != nil {
+		diags.AddError(helpers.ResourceError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+	defer file.Close()
+
+	sha := sha256.New()
+	md := md5.New() //nolint:gosec
+
+	if err := helpers.APIDownload(ctx, client, b.Path.ValueString(), io.MultiWriter(file, sha, md)); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	b.OutputSHA256 = types.StringValue(hex.EncodeToString(sha.Sum(nil)))
+	b.OutputMD5 = types.StringValue(hex.EncodeToString(md.Sum(nil)))
+}
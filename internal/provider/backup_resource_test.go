@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccBackupResourceConfig("test") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBackupResourceConfig("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_backup.test", "type", "manual"),
+					resource.TestCheckResourceAttrSet("readarr_backup.test", "output_sha256"),
+					resource.TestCheckResourceAttrSet("readarr_backup.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccBackupResourceConfig("test") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Replace and Read testing
+			{
+				Config: testAccBackupResourceConfig("replaced"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_backup.test", "output_path", "/tmp/replaced.zip"),
+					resource.TestCheckResourceAttrSet("readarr_backup.test", "output_md5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "readarr_backup.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"output_path", "output_sha256", "output_md5"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBackupResourceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "readarr_backup" "test" {
+		output_path = "/tmp/%s.zip"
+	}`, name)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	backupRestoreResourceName = "backup_restore"
+	backupRestoreUploadPath   = "/api/v1/system/backup/restore/upload"
+	backupRestoreUploadField  = "restore"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupRestoreResource{}
+
+func NewBackupRestoreResource() resource.Resource {
+	return &BackupRestoreResource{}
+}
+
+// BackupRestoreResource defines the backup restore implementation.
+type BackupRestoreResource struct {
+	client *readarr.APIClient
+}
+
+// BackupRestore describes the backup restore data model.
+type BackupRestore struct {
+	ID          types.String `tfsdk:"id"`
+	ArchivePath types.String `tfsdk:"archive_path"`
+	StartTime   types.String `tfsdk:"start_time"`
+	Version     types.String `tfsdk:"version"`
+	BackupID    types.Int64  `tfsdk:"backup_id"`
+}
+
+func (r *BackupRestoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupRestoreResourceName
+}
+
+func (r *BackupRestoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Backup Restore resource.\nIt restores a local archive or an existing backup, then restarts Readarr and waits for it to be back. Destroying it only removes it from the state.\nFor more information refer to [Backup](https://wiki.servarr.com/readarr/system#backup) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Backup Restore ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"archive_path": schema.StringAttribute{
+				MarkdownDescription: "Local backup archive to upload. Conflicts with `backup_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("backup_id")),
+				},
+			},
+			"backup_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of an existing backup to restore. Conflicts with `archive_path`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Start time of the restarted application.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the restarted application.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *BackupRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var restore *BackupRestore
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restore)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current start time to detect the restart
+	status, _, err := r.client.SystemApi.GetSystemStatus(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupRestoreResourceName, err))
+
+		return
+	}
+
+	// Restore backup
+	if restore.BackupID.IsNull() {
+		err = helpers.APIUpload(ctx, r.client, backupRestoreUploadPath, backupRestoreUploadField, restore.ArchivePath.ValueString())
+		restore.ID = types.StringValue(filepath.Base(restore.ArchivePath.ValueString()))
+	} else {
+		_, err = r.client.BackupApi.CreateSystemBackupRestoreById(ctx, int32(restore.BackupID.ValueInt64())).Execute()
+		restore.ID = types.StringValue(strconv.Itoa(int(restore.BackupID.ValueInt64())))
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupRestoreResourceName, err))
+
+		return
+	}
+
+	// Restart to apply the restored backup
+	if _, err = r.client.SystemApi.CreateSystemRestart(ctx).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupRestoreResourceName, err))
+
+		return
+	}
+
+	status, err = helpers.WaitRestart(ctx, r.client, status.GetStartTime(), helpers.RestartPollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupRestoreResourceName, err))
+
+		return
+	}
+
+	// Report health issues without failing, the restore itself succeeded
+	messages, err := helpers.HealthErrors(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupRestoreResourceName, err))
+
+		return
+	}
+
+	if len(messages) != 0 {
+		resp.Diagnostics.AddWarning(helpers.ResourceError, "Readarr restarted with health errors:\n"+strings.Join(messages, "\n"))
+	}
+
+	tflog.Trace(ctx, "created "+backupRestoreResourceName+": "+restore.ID.ValueString())
+	// Generate resource state struct
+	restore.StartTime = types.StringValue(status.GetStartTime().String())
+	restore.Version = types.StringValue(status.GetVersion())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *BackupRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Restore is a one time action, nothing to refresh
+	var restore *BackupRestore
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &restore)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+backupRestoreResourceName+": "+restore.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *BackupRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var restore *BackupRestore
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restore)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Restore cannot be updated, every change requires a replacement
+	tflog.Trace(ctx, "updated "+backupRestoreResourceName+": "+restore.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *BackupRestoreResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Restore cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+backupRestoreResourceName)
+	resp.State.RemoveResource(ctx)
+}
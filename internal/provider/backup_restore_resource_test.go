@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//nolint:paralleltest // restore restarts the application, it cannot run alongside other tests.
+func TestAccBackupRestoreResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid configuration
+			{
+				Config:      testAccBackupRestoreResourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Unauthorized Create
+			{
+				Config:      testAccBackupResourceConfig("restore") + testAccBackupRestoreResourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBackupResourceConfig("restore") + testAccBackupRestoreResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_backup_restore.test", "start_time"),
+					resource.TestCheckResourceAttrSet("readarr_backup_restore.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccBackupRestoreResourceConfig = `
+resource "readarr_backup_restore" "test" {
+	backup_id = readarr_backup.test.id
+}
+`
+
+const testAccBackupRestoreResourceInvalidConfig = `
+resource "readarr_backup_restore" "test" {
+	backup_id = 1
+	archive_path = "/tmp/restore.zip"
+}
+`
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const backupsDataSourceName = "backups"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BackupsDataSource{}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+// BackupsDataSource defines the backups implementation.
+type BackupsDataSource struct {
+	client *readarr.APIClient
+}
+
+// Backups describes the backups data model.
+type Backups struct {
+	Backups types.Set    `tfsdk:"backups"`
+	ID      types.String `tfsdk:"id"`
+}
+
+// BackupFile is part of Backups.
+type BackupFile struct {
+	Name types.String `tfsdk:"name"`
+	Path types.String `tfsdk:"path"`
+	Type types.String `tfsdk:"type"`
+	Time types.String `tfsdk:"time"`
+	ID   types.Int64  `tfsdk:"id"`
+	Size types.Int64  `tfsdk:"size"`
+}
+
+func (b BackupFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name": types.StringType,
+			"path": types.StringType,
+			"type": types.StringType,
+			"time": types.StringType,
+			"id":   types.Int64Type,
+			"size": types.Int64Type,
+		})
+}
+
+func (d *BackupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupsDataSourceName
+}
+
+func (d *BackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->List all available [Backups](../resources/backup).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"backups": schema.SetNestedAttribute{
+				MarkdownDescription: "Backup list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Backup ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Backup name.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Backup path relative to the Readarr URL.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Backup type.",
+							Computed:            true,
+						},
+						"time": schema.StringAttribute{
+							MarkdownDescription: "Backup time.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Backup size in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get backups current value
+	response, _, err := d.client.BackupApi.ListSystemBackup(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+backupsDataSourceName)
+	// Map response body to resource schema attribute
+	backups := make([]BackupFile, len(response))
+	for i, b := range response {
+		backups[i].write(b)
+	}
+
+	backupList, diags := types.SetValueFrom(ctx, BackupFile{}.getType(), backups)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Backups{Backups: backupList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (b *BackupFile) write(backup *readarr.BackupResource) {
+	b.ID = types.Int64Value(int64(backup.GetId()))
+	b.Name = types.StringValue(backup.GetName())
+	b.Path = types.StringValue(backup.GetPath())
+	b.Type = types.StringValue(string(backup.GetType()))
+	b.Size = types.Int64Value(backup.GetSize())
+	b.Time = types.StringValue(backup.GetTime().String())
+}
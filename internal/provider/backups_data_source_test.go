@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBackupsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create a resource to have a value to check
+			{
+				Config: testAccBackupResourceConfig("datasource"),
+			},
+			// Read testing
+			{
+				Config: testAccBackupsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_backups.test", "backups.*", map[string]string{"type": "manual"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccBackupsDataSourceConfig = `
+data "readarr_backups" "test" {
+}
+`
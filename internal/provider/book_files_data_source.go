@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const bookFilesDataSourceName = "book_files"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BookFilesDataSource{}
+
+func NewBookFilesDataSource() datasource.DataSource {
+	return &BookFilesDataSource{}
+}
+
+// BookFilesDataSource defines the book files implementation.
+type BookFilesDataSource struct {
+	client *readarr.APIClient
+}
+
+// BookFiles describes the book files data model.
+type BookFiles struct {
+	Files    types.Set    `tfsdk:"files"`
+	ID       types.String `tfsdk:"id"`
+	AuthorID types.Int64  `tfsdk:"author_id"`
+	BookID   types.Int64  `tfsdk:"book_id"`
+}
+
+// BookFile is part of BookFiles and UnmappedFiles.
+type BookFile struct {
+	Path                types.String  `tfsdk:"path"`
+	Quality             types.String  `tfsdk:"quality"`
+	DateAdded           types.String  `tfsdk:"date_added"`
+	AudioCodec          types.String  `tfsdk:"audio_codec"`
+	AudioBitRate        types.String  `tfsdk:"audio_bit_rate"`
+	AudioBits           types.String  `tfsdk:"audio_bits"`
+	AudioSampleRate     types.String  `tfsdk:"audio_sample_rate"`
+	AudioChannels       types.Float64 `tfsdk:"audio_channels"`
+	ID                  types.Int64   `tfsdk:"id"`
+	AuthorID            types.Int64   `tfsdk:"author_id"`
+	BookID              types.Int64   `tfsdk:"book_id"`
+	Size                types.Int64   `tfsdk:"size"`
+	QualityCutoffNotMet types.Bool    `tfsdk:"quality_cutoff_not_met"`
+}
+
+func (b BookFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"path":                   types.StringType,
+			"quality":                types.StringType,
+			"date_added":             types.StringType,
+			"audio_codec":            types.StringType,
+			"audio_bit_rate":         types.StringType,
+			"audio_bits":             types.StringType,
+			"audio_sample_rate":      types.StringType,
+			"audio_channels":         types.Float64Type,
+			"id":                     types.Int64Type,
+			"author_id":              types.Int64Type,
+			"book_id":                types.Int64Type,
+			"size":                   types.Int64Type,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+// bookFileSchema is the nested schema shared by the book file data sources.
+func bookFileSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Book file ID.",
+				Computed:            true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID.",
+				Computed:            true,
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "File path.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "File size in bytes.",
+				Computed:            true,
+			},
+			"date_added": schema.StringAttribute{
+				MarkdownDescription: "Date added.",
+				Computed:            true,
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality name.",
+				Computed:            true,
+			},
+			"quality_cutoff_not_met": schema.BoolAttribute{
+				MarkdownDescription: "Quality cutoff not met flag.",
+				Computed:            true,
+			},
+			"audio_codec": schema.StringAttribute{
+				MarkdownDescription: "Media info audio codec.",
+				Computed:            true,
+			},
+			"audio_bit_rate": schema.StringAttribute{
+				MarkdownDescription: "Media info audio bit rate.",
+				Computed:            true,
+			},
+			"audio_bits": schema.StringAttribute{
+				MarkdownDescription: "Media info audio bits.",
+				Computed:            true,
+			},
+			"audio_sample_rate": schema.StringAttribute{
+				MarkdownDescription: "Media info audio sample rate.",
+				Computed:            true,
+			},
+			"audio_channels": schema.Float64Attribute{
+				MarkdownDescription: "Media info audio channels.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BookFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + bookFilesDataSourceName
+}
+
+func (d *BookFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->List all book files of an author or a book.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID. Conflicts with `book_id`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("book_id")),
+				},
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID. Conflicts with `author_id`.",
+				Optional:            true,
+			},
+			"files": schema.SetNestedAttribute{
+				MarkdownDescription: "Book file list.",
+				Computed:            true,
+				NestedObject:        bookFileSchema(),
+			},
+		},
+	}
+}
+
+func (d *BookFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *BookFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var files *BookFiles
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &files)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get book files current value
+	request := d.client.BookFileApi.ListBookFile(ctx)
+	if files.BookID.IsNull() {
+		request = request.AuthorId(int32(files.AuthorID.ValueInt64()))
+	} else {
+		request = request.BookId([]int32{int32(files.BookID.ValueInt64())})
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, bookFilesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+bookFilesDataSourceName)
+	// Map response body to resource schema attribute
+	fileList := make([]BookFile, len(response))
+	for i, f := range response {
+		fileList[i].write(f)
+	}
+
+	set, diags := types.SetValueFrom(ctx, BookFile{}.getType(), fileList)
+	resp.Diagnostics.Append(diags...)
+
+	files.Files = set
+	files.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &files)...)
+}
+
+func (b *BookFile) write(file *readarr.BookFileResource) {
+	b.ID = types.Int64Value(int64(file.GetId()))
+	b.AuthorID = types.Int64Value(int64(file.GetAuthorId()))
+	b.BookID = types.Int64Value(int64(file.GetBookId()))
+	b.Path = types.StringValue(file.GetPath())
+	b.Size = types.Int64Value(file.GetSize())
+	b.DateAdded = types.StringValue(file.GetDateAdded().String())
+	b.Quality = types.StringValue(file.GetQuality().Quality.GetName())
+	b.QualityCutoffNotMet = types.BoolValue(file.GetQualityCutoffNotMet())
+	b.AudioCodec = types.StringValue(file.MediaInfo.GetAudioCodec())
+	b.AudioBitRate = types.StringValue(file.MediaInfo.GetAudioBitRate())
+	b.AudioBits = types.StringValue(file.MediaInfo.GetAudioBits())
+	b.AudioSampleRate = types.StringValue(file.MediaInfo.GetAudioSampleRate())
+	b.AudioChannels = types.Float64Value(file.MediaInfo.GetAudioChannels())
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBookFilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBookFilesDataSourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAuthorResourceConfig("Mark Twain", "marktwain", "1244") + testAccBookFilesDataSourceConfig("readarr_author.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_book_files.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBookFilesDataSourceConfig(author string) string {
+	return `
+	data "readarr_book_files" "test" {
+		author_id = ` + author + `
+	}`
+}
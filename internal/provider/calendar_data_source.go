@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	calendarDataSourceName = "calendar"
+	calendarDateLayout     = "2006-01-02"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CalendarDataSource{}
+
+func NewCalendarDataSource() datasource.DataSource {
+	return &CalendarDataSource{}
+}
+
+// CalendarDataSource defines the calendar implementation.
+type CalendarDataSource struct {
+	client *readarr.APIClient
+}
+
+// Calendar describes the calendar data model.
+type Calendar struct {
+	Books       types.Set    `tfsdk:"books"`
+	ID          types.String `tfsdk:"id"`
+	Start       types.String `tfsdk:"start"`
+	End         types.String `tfsdk:"end"`
+	Unmonitored types.Bool   `tfsdk:"unmonitored"`
+}
+
+func (d *CalendarDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + calendarDataSourceName
+}
+
+func (d *CalendarDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Authors -->List all books releasing in a time window.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Window start, as `YYYY-MM-DD` date or RFC3339 timestamp. Defaults to today.",
+				Optional:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "Window end, as `YYYY-MM-DD` date or RFC3339 timestamp. Defaults to two days after today.",
+				Optional:            true,
+			},
+			"unmonitored": schema.BoolAttribute{
+				MarkdownDescription: "Include unmonitored books. Defaults to `false`.",
+				Optional:            true,
+			},
+			"books": schema.SetNestedAttribute{
+				MarkdownDescription: "Book list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Book title.",
+							Computed:            true,
+						},
+						"author_id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID.",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Author name.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CalendarDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *CalendarDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var calendar *Calendar
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &calendar)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.client.CalendarApi.ListCalendar(ctx).IncludeAuthor(true).Unmonitored(calendar.Unmonitored.ValueBool())
+
+	if !calendar.Start.IsNull() {
+		start, err := parseCalendarDate(calendar.Start.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("start"), helpers.DataSourceError, err.Error())
+
+			return
+		}
+
+		request = request.Start(start)
+	}
+
+	if !calendar.End.IsNull() {
+		end, err := parseCalendarDate(calendar.End.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("end"), helpers.DataSourceError, err.Error())
+
+			return
+		}
+
+		request = request.End(end)
+	}
+
+	// Get calendar current value
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, calendarDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+calendarDataSourceName)
+	// Map response body to resource schema attribute
+	books := make([]WantedBook, len(response))
+	for i, b := range response {
+		books[i].write(b)
+	}
+
+	bookList, diags := types.SetValueFrom(ctx, WantedBook{}.getType(), books)
+	resp.Diagnostics.Append(diags...)
+
+	calendar.Books = bookList
+	calendar.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &calendar)...)
+}
+
+// parseCalendarDate accepts both plain dates and RFC3339 timestamps.
+func parseCalendarDate(value string) (time.Time, error) {
+	if date, err := time.Parse(calendarDateLayout, value); err == nil {
+		return date, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
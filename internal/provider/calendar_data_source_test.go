@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCalendarDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCalendarDataSourceConfig("2023-01-01") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      testAccCalendarDataSourceConfig("01/01/2023"),
+				ExpectError: regexp.MustCompile("Data Source Error"),
+			},
+			// Read testing
+			{
+				Config: testAccCalendarDataSourceConfig("2023-01-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_calendar.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCalendarDataSourceConfig(start string) string {
+	return `
+	data "readarr_calendar" "test" {
+		start = "` + start + `"
+		end = "2023-12-31T23:59:59Z"
+		unmonitored = true
+	}`
+}
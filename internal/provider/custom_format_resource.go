@@ -243,7 +243,7 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *CustomFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, customFormatResourceName, path.Root("id"), req, resp, customFormatLookup(r.client))
 	tflog.Trace(ctx, "imported "+customFormatResourceName+": "+req.ID)
 }
 
@@ -279,3 +279,21 @@ func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *reada
 
 	return format
 }
+
+// customFormatLookup returns an import lookup by name for custom formats.
+func customFormatLookup(client *readarr.APIClient) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.CustomFormatApi.ListCustomFormat(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
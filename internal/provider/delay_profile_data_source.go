@@ -65,6 +65,11 @@ func (d *DelayProfileDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"preferred_protocol": schema.StringAttribute{
 				MarkdownDescription: "Preferred protocol.",
 				Computed:            true,
@@ -96,6 +101,7 @@ func (d *DelayProfileDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	data.find(ctx, data.ID.ValueInt64(), response, &resp.Diagnostics)
+	helpers.WriteTagLabels(ctx, d.client, data.Tags, &data.TagLabels, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "read "+delayProfileDataSourceName)
 	// Map response body to resource schema attribute
@@ -25,6 +25,7 @@ const delayProfileResourceName = "delay_profile"
 var (
 	_ resource.Resource                = &DelayProfileResource{}
 	_ resource.ResourceWithImportState = &DelayProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &DelayProfileResource{}
 )
 
 func NewDelayProfileResource() resource.Resource {
@@ -33,12 +34,29 @@ func NewDelayProfileResource() resource.Resource {
 
 // DelayProfileResource defines the delay profile implementation.
 type DelayProfileResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DelayProfile describes the delay profile data model.
 type DelayProfile struct {
 	Tags              types.Set    `tfsdk:"tags"`
+	TagLabels         types.Set    `tfsdk:"tag_labels"`
+	PreferredProtocol types.String `tfsdk:"preferred_protocol"`
+	UsenetDelay       types.Int64  `tfsdk:"usenet_delay"`
+	TorrentDelay      types.Int64  `tfsdk:"torrent_delay"`
+	ID                types.Int64  `tfsdk:"id"`
+	Order             types.Int64  `tfsdk:"order"`
+	EnableUsenet      types.Bool   `tfsdk:"enable_usenet"`
+	EnableTorrent     types.Bool   `tfsdk:"enable_torrent"`
+}
+
+// ManagedDelayProfile describes the delay profile resource data model, the DelayProfile fields plus the resource only attributes.
+type ManagedDelayProfile struct {
+	Tags              types.Set    `tfsdk:"tags"`
+	TagLabels         types.Set    `tfsdk:"tag_labels"`
+	TagsAll           types.Set    `tfsdk:"tags_all"`
 	PreferredProtocol types.String `tfsdk:"preferred_protocol"`
 	UsenetDelay       types.Int64  `tfsdk:"usenet_delay"`
 	TorrentDelay      types.Int64  `tfsdk:"torrent_delay"`
@@ -52,6 +70,7 @@ func (p DelayProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":               types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":         types.SetType{}.WithElementType(types.StringType),
 			"preferred_protocol": types.StringType,
 			"usenet_delay":       types.Int64Type,
 			"torrent_delay":      types.Int64Type,
@@ -107,6 +126,8 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 				Required:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"preferred_protocol": schema.StringAttribute{
 				MarkdownDescription: "Preferred protocol.",
 				Optional:            true,
@@ -120,14 +141,20 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *DelayProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DelayProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *DelayProfile
+	var profile *ManagedDelayProfile
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -136,7 +163,13 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Build Create resource
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, profile.Tags)
 	request := profile.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, profile.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Create new DelayProfile
 	response, _, err := r.client.DelayProfileApi.CreateDelayProfile(ctx).DelayProfileResource(*request).Execute()
@@ -162,12 +195,13 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &profile.Tags, &profile.TagLabels, &profile.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *DelayProfile
+	var profile *ManagedDelayProfile
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -185,13 +219,15 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 
 	tflog.Trace(ctx, "read "+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, profile.Tags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &profile.Tags, &profile.TagLabels, &profile.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *DelayProfile
+	var profile *ManagedDelayProfile
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -200,7 +236,13 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Build Update resource
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, profile.Tags)
 	request := profile.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, profile.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update DelayProfile
 	response, _, err := r.client.DelayProfileApi.UpdateDelayProfile(ctx, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
@@ -213,6 +255,7 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &profile.Tags, &profile.TagLabels, &profile.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -269,3 +312,39 @@ func (p *DelayProfile) read(ctx context.Context, diags *diag.Diagnostics) *reada
 
 	return profile
 }
+
+func (p ManagedDelayProfile) toDelayProfile() *DelayProfile {
+	return &DelayProfile{
+		Tags:              p.Tags,
+		TagLabels:         p.TagLabels,
+		PreferredProtocol: p.PreferredProtocol,
+		UsenetDelay:       p.UsenetDelay,
+		TorrentDelay:      p.TorrentDelay,
+		ID:                p.ID,
+		Order:             p.Order,
+		EnableUsenet:      p.EnableUsenet,
+		EnableTorrent:     p.EnableTorrent,
+	}
+}
+
+func (p *ManagedDelayProfile) fromDelayProfile(profile *DelayProfile) {
+	p.Tags = profile.Tags
+	p.TagLabels = profile.TagLabels
+	p.PreferredProtocol = profile.PreferredProtocol
+	p.UsenetDelay = profile.UsenetDelay
+	p.TorrentDelay = profile.TorrentDelay
+	p.ID = profile.ID
+	p.Order = profile.Order
+	p.EnableUsenet = profile.EnableUsenet
+	p.EnableTorrent = profile.EnableTorrent
+}
+
+func (p *ManagedDelayProfile) write(ctx context.Context, profile *readarr.DelayProfileResource, diags *diag.Diagnostics) {
+	genericDelayProfile := p.toDelayProfile()
+	genericDelayProfile.write(ctx, profile, diags)
+	p.fromDelayProfile(genericDelayProfile)
+}
+
+func (p *ManagedDelayProfile) read(ctx context.Context, diags *diag.Diagnostics) *readarr.DelayProfileResource {
+	return p.toDelayProfile().read(ctx, diags)
+}
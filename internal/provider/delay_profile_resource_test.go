@@ -25,6 +25,7 @@ func TestAccDelayProfileResource(t *testing.T) {
 				Config: testAccTagResourceConfig("test", "delay_profile_resource") + testAccDelayProfileResourceConfig("usenet", "readarr_tag.test.id"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("readarr_delay_profile.test", "preferred_protocol", "usenet"),
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tag_labels.0", "delay_profile_resource"),
 					resource.TestCheckResourceAttrSet("readarr_delay_profile.test", "id"),
 				),
 			},
@@ -51,6 +52,74 @@ func TestAccDelayProfileResource(t *testing.T) {
 	})
 }
 
+func TestAccDelayProfileResourceTagLabels(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing tag
+			{
+				Config:      testAccDelayProfileResourceLabelsConfig("delay_profile_labels"),
+				ExpectError: regexp.MustCompile("Tag Not Found"),
+			},
+			// Create missing tag
+			{
+				Config: testAccDelayProfileResourceLabelsConfig("delay_profile_labels") + testCreateMissingTagsProvider,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tag_labels.0", "delay_profile_labels"),
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tags.#", "1"),
+				),
+			},
+			// Existing tag
+			{
+				Config: testAccDelayProfileResourceLabelsConfig("delay_profile_labels"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tag_labels.0", "delay_profile_labels"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "readarr_delay_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDelayProfileResourceDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with default tags only
+			{
+				Config: testAccDelayProfileResourceDefaultTagsConfig("") + testDefaultTagsProvider,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tag_labels.0", "terraform-managed"),
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tags_all.#", "1"),
+				),
+			},
+			// Explicit tags keep default tags out of tags, but not out of tags_all
+			{
+				Config: testAccTagResourceConfig("test", "delay_profile_default") + testAccDelayProfileResourceDefaultTagsConfig("tags = [readarr_tag.test.id]") + testDefaultTagsProvider,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tag_labels.0", "delay_profile_default"),
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("readarr_delay_profile.test", "tags_all.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccDelayProfileResourceConfig(protocol, tag string) string {
 	return fmt.Sprintf(`
 	resource "readarr_delay_profile" "test" {
@@ -63,3 +132,29 @@ func testAccDelayProfileResourceConfig(protocol, tag string) string {
 		tags = [%s]
 	}`, protocol, tag)
 }
+
+func testAccDelayProfileResourceLabelsConfig(label string) string {
+	return fmt.Sprintf(`
+	resource "readarr_delay_profile" "test" {
+		enable_usenet = true
+		enable_torrent = true
+		order = 101
+		usenet_delay = 0
+		torrent_delay = 0
+		preferred_protocol= "usenet"
+		tag_labels = ["%s"]
+	}`, label)
+}
+
+func testAccDelayProfileResourceDefaultTagsConfig(tags string) string {
+	return fmt.Sprintf(`
+	resource "readarr_delay_profile" "test" {
+		enable_usenet = true
+		enable_torrent = true
+		order = 102
+		usenet_delay = 0
+		torrent_delay = 0
+		preferred_protocol= "usenet"
+		%s
+	}`, tags)
+}
@@ -79,6 +79,11 @@ func (d *DelayProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"preferred_protocol": schema.StringAttribute{
 							MarkdownDescription: "Preferred protocol.",
 							Computed:            true,
@@ -105,11 +110,20 @@ func (d *DelayProfilesDataSource) Read(ctx context.Context, _ datasource.ReadReq
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+delayProfileResourceName)
 	// Map response body to resource schema attribute
 	profiles := make([]DelayProfile, len(response))
 	for i, p := range response {
 		profiles[i].write(ctx, p, &resp.Diagnostics)
+		profiles[i].TagLabels = helpers.TagLabels(ctx, tagList, profiles[i].Tags, &resp.Diagnostics)
 	}
 
 	profileList, diags := types.SetValueFrom(ctx, DelayProfile{}.getType(), profiles)
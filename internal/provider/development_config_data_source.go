@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const developmentConfigDataSourceName = "development_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DevelopmentConfigDataSource{}
+
+func NewDevelopmentConfigDataSource() datasource.DataSource {
+	return &DevelopmentConfigDataSource{}
+}
+
+// DevelopmentConfigDataSource defines the development config implementation.
+type DevelopmentConfigDataSource struct {
+	client *readarr.APIClient
+}
+
+func (d *DevelopmentConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + developmentConfigDataSourceName
+}
+
+func (d *DevelopmentConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Metadata -->[Development Config](../resources/development_config).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Development Config ID.",
+				Computed:            true,
+			},
+			"metadata_source": schema.StringAttribute{
+				MarkdownDescription: "Metadata source URL. Empty to use the official one.",
+				Computed:            true,
+			},
+			"console_log_level": schema.StringAttribute{
+				MarkdownDescription: "Console log level.",
+				Computed:            true,
+			},
+			"log_rotate": schema.Int64Attribute{
+				MarkdownDescription: "Number of log files to keep.",
+				Computed:            true,
+			},
+			"log_sql": schema.BoolAttribute{
+				MarkdownDescription: "Log SQL flag.",
+				Computed:            true,
+			},
+			"filter_sentry_events": schema.BoolAttribute{
+				MarkdownDescription: "Filter sentry events flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DevelopmentConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *DevelopmentConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get development config current value
+	response, _, err := d.client.DevelopmentConfigApi.GetDevelopmentConfig(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, developmentConfigDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+developmentConfigDataSourceName)
+
+	config := DevelopmentConfig{}
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
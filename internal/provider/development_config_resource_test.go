@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevelopmentConfigResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccDevelopmentConfigResourceConfig("debug") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDevelopmentConfigResourceConfig("info"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_development_config.test", "console_log_level", "info"),
+					resource.TestCheckResourceAttr("readarr_development_config.test", "metadata_source", ""),
+					resource.TestCheckResourceAttrSet("readarr_development_config.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccDevelopmentConfigResourceConfig("debug") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDevelopmentConfigResourceConfig("debug"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_development_config.test", "console_log_level", "debug"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "readarr_development_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDevelopmentConfigResourceConfig(level string) string {
+	return fmt.Sprintf(`
+	resource "readarr_development_config" "test" {
+		console_log_level = "%s"
+		log_rotate = 50
+		log_sql = false
+		filter_sentry_events = true
+	}`, level)
+}
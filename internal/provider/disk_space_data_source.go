@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const diskSpaceDataSourceName = "disk_space"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DiskSpaceDataSource{}
+
+func NewDiskSpaceDataSource() datasource.DataSource {
+	return &DiskSpaceDataSource{}
+}
+
+// DiskSpaceDataSource defines the disk space implementation.
+type DiskSpaceDataSource struct {
+	client *readarr.APIClient
+}
+
+// DiskSpaces describes the disk spaces data model.
+type DiskSpaces struct {
+	DiskSpaces types.Set    `tfsdk:"disk_spaces"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// DiskSpace is part of DiskSpaces.
+type DiskSpace struct {
+	Path       types.String `tfsdk:"path"`
+	Label      types.String `tfsdk:"label"`
+	FreeSpace  types.Int64  `tfsdk:"free_space"`
+	TotalSpace types.Int64  `tfsdk:"total_space"`
+}
+
+func (d DiskSpace) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"path":        types.StringType,
+			"label":       types.StringType,
+			"free_space":  types.Int64Type,
+			"total_space": types.Int64Type,
+		})
+}
+
+func (d *DiskSpaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + diskSpaceDataSourceName
+}
+
+func (d *DiskSpaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->List all mounts with their disk space.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"disk_spaces": schema.SetNestedAttribute{
+				MarkdownDescription: "Disk space list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Mount path.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Mount label.",
+							Computed:            true,
+						},
+						"free_space": schema.Int64Attribute{
+							MarkdownDescription: "Free space in bytes.",
+							Computed:            true,
+						},
+						"total_space": schema.Int64Attribute{
+							MarkdownDescription: "Total space in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DiskSpaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *DiskSpaceDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get disk space current value
+	response, _, err := d.client.DiskSpaceApi.ListDiskSpace(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, diskSpaceDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+diskSpaceDataSourceName)
+	// Map response body to resource schema attribute
+	spaces := make([]DiskSpace, len(response))
+	for i, s := range response {
+		spaces[i].write(s)
+	}
+
+	spaceList, diags := types.SetValueFrom(ctx, DiskSpace{}.getType(), spaces)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, DiskSpaces{DiskSpaces: spaceList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (d *DiskSpace) write(space *readarr.DiskSpaceResource) {
+	d.Path = types.StringValue(space.GetPath())
+	d.Label = types.StringValue(space.GetLabel())
+	d.FreeSpace = types.Int64Value(space.GetFreeSpace())
+	d.TotalSpace = types.Int64Value(space.GetTotalSpace())
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDiskSpaceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccDiskSpaceDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccDiskSpaceDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_disk_space.test", "disk_spaces.0.total_space"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDiskSpaceDataSourceConfig = `
+data "readarr_disk_space" "test" {
+}
+`
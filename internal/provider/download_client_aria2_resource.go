@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientAria2Resource{}
 	_ resource.ResourceWithImportState = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientAria2Resource{}
 )
 
 func NewDownloadClientAria2Resource() resource.Resource {
@@ -35,12 +37,16 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientAria2 describes the download client data model.
 type DownloadClientAria2 struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	RPCPath                  types.String `tfsdk:"rpc_path"`
@@ -52,11 +58,13 @@ type DownloadClientAria2 struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		RPCPath:                  d.RPCPath,
@@ -76,6 +84,7 @@ func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientAria2) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.RPCPath = client.RPCPath
@@ -97,6 +106,10 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Aria2 resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [Aria2](https://wiki.servarr.com/readarr/supported#aria2).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -127,6 +140,8 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -165,11 +180,17 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientAria2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientAria2
@@ -181,9 +202,15 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create new DownloadClientAria2
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
 
@@ -193,6 +220,7 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -214,9 +242,18 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientAria2ResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientAria2Implementation, downloadClientAria2ConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -231,7 +268,13 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	}
 
 	// Update DownloadClientAria2
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -243,6 +286,7 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -268,10 +312,16 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientAria2ResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientAria2Implementation))
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientAria2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientAria2ResourceName, downloadClientAria2Implementation, downloadClientAria2ConfigContract, &DownloadClientAria2{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientAria2) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -72,6 +72,11 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -244,6 +249,7 @@ func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	helpers.WriteTagLabels(ctx, d.client, data.Tags, &data.TagLabels, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+downloadClientDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientDelugeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientDelugeResource{}
 )
 
 func NewDownloadClientDelugeResource() resource.Resource {
@@ -37,12 +39,16 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientDeluge describes the download client data model.
 type DownloadClientDeluge struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -59,11 +65,13 @@ type DownloadClientDeluge struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -88,6 +96,7 @@ func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientDeluge) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -114,6 +123,10 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Deluge resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [Deluge](https://wiki.servarr.com/readarr/supported#deluge).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -144,6 +157,8 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -214,11 +229,17 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientDelugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientDeluge
@@ -230,9 +251,15 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 	}
 
 	// Create new DownloadClientDeluge
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
 
@@ -242,6 +269,7 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -263,9 +291,18 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientDelugeResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientDelugeImplementation, downloadClientDelugeConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -280,7 +317,13 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 	}
 
 	// Update DownloadClientDeluge
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -292,6 +335,7 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -317,10 +361,16 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientDelugeResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientDelugeImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientDelugeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientDelugeResourceName, downloadClientDelugeImplementation, downloadClientDelugeConfigContract, &DownloadClientDeluge{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientDeluge) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientFloodResource{}
 	_ resource.ResourceWithImportState = &DownloadClientFloodResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientFloodResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientFloodResource{}
 )
 
 func NewDownloadClientFloodResource() resource.Resource {
@@ -35,12 +37,16 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientFlood describes the download client data model.
 type DownloadClientFlood struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
 	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
@@ -58,11 +64,13 @@ type DownloadClientFlood struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		FieldTags:                d.FieldTags,
 		PostImportTags:           d.PostImportTags,
 		AdditionalTags:           d.AdditionalTags,
@@ -88,6 +96,7 @@ func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientFlood) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.FieldTags = client.FieldTags
 	d.AdditionalTags = client.AdditionalTags
 	d.PostImportTags = client.PostImportTags
@@ -115,6 +124,10 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Flood resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [Flood](https://wiki.servarr.com/readarr/supported#flood).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -145,6 +158,8 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -217,11 +232,17 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientFloodResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientFlood
@@ -233,9 +254,15 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create new DownloadClientFlood
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
 
@@ -245,6 +272,7 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -266,9 +294,18 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientFloodResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientFloodImplementation, downloadClientFloodConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -283,7 +320,13 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Update DownloadClientFlood
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -295,6 +338,7 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -320,10 +364,16 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientFloodResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientFloodImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientFloodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientFloodResourceName, downloadClientFloodImplementation, downloadClientFloodConfigContract, &DownloadClientFlood{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientFlood) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
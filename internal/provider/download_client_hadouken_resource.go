@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientHadoukenResource{}
 	_ resource.ResourceWithImportState = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientHadoukenResource{}
 )
 
 func NewDownloadClientHadoukenResource() resource.Resource {
@@ -35,12 +37,16 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -54,11 +60,13 @@ type DownloadClientHadouken struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -80,6 +88,7 @@ func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientHadouken) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -103,6 +112,10 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Hadouken resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [Hadouken](https://wiki.servarr.com/readarr/supported#hadouken).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -133,6 +146,8 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -180,11 +195,17 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientHadoukenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientHadouken
@@ -196,9 +217,15 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	}
 
 	// Create new DownloadClientHadouken
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
 
@@ -208,6 +235,7 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	tflog.Trace(ctx, "created "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -229,9 +257,18 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientHadoukenResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientHadoukenImplementation, downloadClientHadoukenConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -246,7 +283,13 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	}
 
 	// Update DownloadClientHadouken
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -258,6 +301,7 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	tflog.Trace(ctx, "updated "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -283,10 +327,16 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientHadoukenResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientHadoukenImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientHadoukenResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientHadoukenResourceName, downloadClientHadoukenImplementation, downloadClientHadoukenConfigContract, &DownloadClientHadouken{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientHadouken) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientNzbvortexResource{}
 	_ resource.ResourceWithImportState = &DownloadClientNzbvortexResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientNzbvortexResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientNzbvortexResource{}
 )
 
 func NewDownloadClientNzbvortexResource() resource.Resource {
@@ -37,12 +39,16 @@ func NewDownloadClientNzbvortexResource() resource.Resource {
 
 // DownloadClientNzbvortexResource defines the download client implementation.
 type DownloadClientNzbvortexResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientNzbvortex describes the download client data model.
 type DownloadClientNzbvortex struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -56,11 +62,13 @@ type DownloadClientNzbvortex struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientNzbvortex) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -82,6 +90,7 @@ func (d DownloadClientNzbvortex) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientNzbvortex) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -105,6 +114,10 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Nzbvortex resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [Nzbvortex](https://wiki.servarr.com/readarr/supported#nzbvortex).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -135,6 +148,8 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -188,11 +203,17 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 }
 
 func (r *DownloadClientNzbvortexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientNzbvortexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientNzbvortex
@@ -204,9 +225,15 @@ func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resour
 	}
 
 	// Create new DownloadClientNzbvortex
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbvortexResourceName, err))
 
@@ -216,6 +243,7 @@ func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "created "+downloadClientNzbvortexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -237,9 +265,18 @@ func (r *DownloadClientNzbvortexResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientNzbvortexResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientNzbvortexImplementation, downloadClientNzbvortexConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientNzbvortexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -254,7 +291,13 @@ func (r *DownloadClientNzbvortexResource) Update(ctx context.Context, req resour
 	}
 
 	// Update DownloadClientNzbvortex
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -266,6 +309,7 @@ func (r *DownloadClientNzbvortexResource) Update(ctx context.Context, req resour
 	tflog.Trace(ctx, "updated "+downloadClientNzbvortexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -291,10 +335,16 @@ func (r *DownloadClientNzbvortexResource) Delete(ctx context.Context, req resour
 }
 
 func (r *DownloadClientNzbvortexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientNzbvortexResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientNzbvortexImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientNzbvortexResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientNzbvortexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientNzbvortexResourceName, downloadClientNzbvortexImplementation, downloadClientNzbvortexConfigContract, &DownloadClientNzbvortex{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientNzbvortex) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientPneumaticResource{}
 	_ resource.ResourceWithImportState = &DownloadClientPneumaticResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientPneumaticResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientPneumaticResource{}
 )
 
 func NewDownloadClientPneumaticResource() resource.Resource {
@@ -35,12 +37,16 @@ func NewDownloadClientPneumaticResource() resource.Resource {
 
 // DownloadClientPneumaticResource defines the download client implementation.
 type DownloadClientPneumaticResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientPneumatic describes the download client data model.
 type DownloadClientPneumatic struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	StrmFolder               types.String `tfsdk:"strm_folder"`
@@ -49,11 +55,13 @@ type DownloadClientPneumatic struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientPneumatic) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		NzbFolder:                d.NzbFolder,
 		StrmFolder:               d.StrmFolder,
@@ -70,6 +78,7 @@ func (d DownloadClientPneumatic) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientPneumatic) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.NzbFolder = client.NzbFolder
 	d.StrmFolder = client.StrmFolder
@@ -88,6 +97,10 @@ func (r *DownloadClientPneumaticResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Pneumatic resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [Pneumatic](https://wiki.servarr.com/readarr/supported#pneumatic).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -118,6 +131,8 @@ func (r *DownloadClientPneumaticResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -139,11 +154,17 @@ func (r *DownloadClientPneumaticResource) Schema(_ context.Context, _ resource.S
 }
 
 func (r *DownloadClientPneumaticResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientPneumaticResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientPneumatic
@@ -155,9 +176,15 @@ func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resour
 	}
 
 	// Create new DownloadClientPneumatic
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientPneumaticResourceName, err))
 
@@ -167,6 +194,7 @@ func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "created "+downloadClientPneumaticResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -188,9 +216,18 @@ func (r *DownloadClientPneumaticResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientPneumaticResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientPneumaticImplementation, downloadClientPneumaticConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientPneumaticResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -205,7 +242,13 @@ func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resour
 	}
 
 	// Update DownloadClientPneumatic
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -217,6 +260,7 @@ func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resour
 	tflog.Trace(ctx, "updated "+downloadClientPneumaticResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -242,10 +286,16 @@ func (r *DownloadClientPneumaticResource) Delete(ctx context.Context, req resour
 }
 
 func (r *DownloadClientPneumaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientPneumaticResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientPneumaticImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientPneumaticResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientPneumaticResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientPneumaticResourceName, downloadClientPneumaticImplementation, downloadClientPneumaticConfigContract, &DownloadClientPneumatic{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientPneumatic) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -31,6 +31,8 @@ var downloadClientQbittorrentInitialStates = []int64{0, 1, 2}
 var (
 	_ resource.Resource                = &DownloadClientQbittorrentResource{}
 	_ resource.ResourceWithImportState = &DownloadClientQbittorrentResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientQbittorrentResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientQbittorrentResource{}
 )
 
 func NewDownloadClientQbittorrentResource() resource.Resource {
@@ -39,12 +41,16 @@ func NewDownloadClientQbittorrentResource() resource.Resource {
 
 // DownloadClientQbittorrentResource defines the download client implementation.
 type DownloadClientQbittorrentResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientQbittorrent describes the download client data model.
 type DownloadClientQbittorrent struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	MusicImportedCategory    types.String `tfsdk:"book_imported_category"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
@@ -64,11 +70,13 @@ type DownloadClientQbittorrent struct {
 	SequentialOrder          types.Bool   `tfsdk:"sequential_order"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientQbittorrent) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -96,6 +104,7 @@ func (d DownloadClientQbittorrent) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientQbittorrent) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -125,6 +134,10 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client qBittorrent resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [qBittorrent](https://wiki.servarr.com/readarr/supported#qbittorrent).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -155,6 +168,8 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -243,11 +258,17 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 }
 
 func (r *DownloadClientQbittorrentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientQbittorrentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientQbittorrent
@@ -259,9 +280,15 @@ func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req reso
 	}
 
 	// Create new DownloadClientQbittorrent
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientQbittorrentResourceName, err))
 
@@ -271,6 +298,7 @@ func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req reso
 	tflog.Trace(ctx, "created "+downloadClientQbittorrentResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -292,9 +320,18 @@ func (r *DownloadClientQbittorrentResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientQbittorrentResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientQbittorrentImplementation, downloadClientQbittorrentConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientQbittorrentResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -309,7 +346,13 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 	}
 
 	// Update DownloadClientQbittorrent
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -321,6 +364,7 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 	tflog.Trace(ctx, "updated "+downloadClientQbittorrentResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -346,10 +390,16 @@ func (r *DownloadClientQbittorrentResource) Delete(ctx context.Context, req reso
 }
 
 func (r *DownloadClientQbittorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientQbittorrentResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientQbittorrentImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientQbittorrentResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientQbittorrentResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientQbittorrentResourceName, downloadClientQbittorrentImplementation, downloadClientQbittorrentConfigContract, &DownloadClientQbittorrent{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientQbittorrent) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"strconv"
 
 	"github.com/devopsarr/readarr-go/readarr"
@@ -26,6 +27,7 @@ const downloadClientResourceName = "download_client"
 var (
 	_ resource.Resource                = &DownloadClientResource{}
 	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientResource{}
 )
 
 var downloadClientFields = helpers.Fields{
@@ -43,12 +45,15 @@ func NewDownloadClientResource() resource.Resource {
 
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClient describes the download client data model.
 type DownloadClient struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
@@ -94,10 +99,62 @@ type DownloadClient struct {
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
 }
 
+// ManagedDownloadClient describes the download client resource data model, the DownloadClient fields plus the resource options.
+type ManagedDownloadClient struct {
+	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
+	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
+	FieldTags                types.Set    `tfsdk:"field_tags"`
+	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
+	NzbFolder                types.String `tfsdk:"nzb_folder"`
+	Category                 types.String `tfsdk:"category"`
+	Implementation           types.String `tfsdk:"implementation"`
+	Name                     types.String `tfsdk:"name"`
+	Protocol                 types.String `tfsdk:"protocol"`
+	MagnetFileExtension      types.String `tfsdk:"magnet_file_extension"`
+	TorrentFolder            types.String `tfsdk:"torrent_folder"`
+	StrmFolder               types.String `tfsdk:"strm_folder"`
+	Host                     types.String `tfsdk:"host"`
+	ConfigContract           types.String `tfsdk:"config_contract"`
+	Destination              types.String `tfsdk:"destination"`
+	MusicDirectory           types.String `tfsdk:"bookdirectory"`
+	TVDirectory              types.String `tfsdk:"book_directory"`
+	Username                 types.String `tfsdk:"username"`
+	MusicImportedCategory    types.String `tfsdk:"book_imported_category"`
+	MusicCategory            types.String `tfsdk:"book_category"`
+	Password                 types.String `tfsdk:"password"`
+	SecretToken              types.String `tfsdk:"secret_token"`
+	RPCPath                  types.String `tfsdk:"rpc_path"`
+	URLBase                  types.String `tfsdk:"url_base"`
+	APIKey                   types.String `tfsdk:"api_key"`
+	WatchFolder              types.String `tfsdk:"watch_folder"`
+	RecentTVPriority         types.Int64  `tfsdk:"recent_book_priority"`
+	IntialState              types.Int64  `tfsdk:"intial_state"`
+	InitialState             types.Int64  `tfsdk:"initial_state"`
+	OlderTVPriority          types.Int64  `tfsdk:"older_book_priority"`
+	Priority                 types.Int64  `tfsdk:"priority"`
+	Port                     types.Int64  `tfsdk:"port"`
+	ID                       types.Int64  `tfsdk:"id"`
+	AddStopped               types.Bool   `tfsdk:"add_stopped"`
+	SaveMagnetFiles          types.Bool   `tfsdk:"save_magnet_files"`
+	ReadOnly                 types.Bool   `tfsdk:"read_only"`
+	FirstAndLast             types.Bool   `tfsdk:"first_and_last"`
+	SequentialOrder          types.Bool   `tfsdk:"sequential_order"`
+	StartOnAdd               types.Bool   `tfsdk:"start_on_add"`
+	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
+	AddPaused                types.Bool   `tfsdk:"add_paused"`
+	Enable                   types.Bool   `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                       types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                 types.SetType{}.WithElementType(types.StringType),
 			"additional_tags":            types.SetType{}.WithElementType(types.Int64Type),
 			"post_import_tags":           types.SetType{}.WithElementType(types.StringType),
 			"field_tags":                 types.SetType{}.WithElementType(types.StringType),
@@ -152,6 +209,10 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Generic Download Client resource. When possible use a specific resource instead.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -197,6 +258,8 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -394,14 +457,20 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *DownloadClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *DownloadClient
+	var client *ManagedDownloadClient
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -410,9 +479,15 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Create new DownloadClient
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
 
@@ -422,15 +497,17 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state ManagedDownloadClient
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = client.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client DownloadClient
+	var client ManagedDownloadClient
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -449,15 +526,18 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state ManagedDownloadClient
 
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = client.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var client *DownloadClient
+	var client *ManagedDownloadClient
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -466,7 +546,13 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update DownloadClient
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -478,9 +564,11 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state ManagedDownloadClient
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = client.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -506,7 +594,7 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, ""))
 	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
 }
 
@@ -547,3 +635,152 @@ func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *rea
 
 	return client
 }
+
+// downloadClientLookup returns an import lookup by name for download clients, restricted to the given implementation if not empty.
+func downloadClientLookup(client *readarr.APIClient, implementation string) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.DownloadClientApi.ListDownloadClient(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name && (implementation == "" || item.GetImplementation() == implementation) {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
+
+func (d ManagedDownloadClient) toDownloadClient() *DownloadClient {
+	return &DownloadClient{
+		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
+		PostImportTags:           d.PostImportTags,
+		FieldTags:                d.FieldTags,
+		AdditionalTags:           d.AdditionalTags,
+		NzbFolder:                d.NzbFolder,
+		Category:                 d.Category,
+		Implementation:           d.Implementation,
+		Name:                     d.Name,
+		Protocol:                 d.Protocol,
+		MagnetFileExtension:      d.MagnetFileExtension,
+		TorrentFolder:            d.TorrentFolder,
+		StrmFolder:               d.StrmFolder,
+		Host:                     d.Host,
+		ConfigContract:           d.ConfigContract,
+		Destination:              d.Destination,
+		MusicDirectory:           d.MusicDirectory,
+		TVDirectory:              d.TVDirectory,
+		Username:                 d.Username,
+		MusicImportedCategory:    d.MusicImportedCategory,
+		MusicCategory:            d.MusicCategory,
+		Password:                 d.Password,
+		SecretToken:              d.SecretToken,
+		RPCPath:                  d.RPCPath,
+		URLBase:                  d.URLBase,
+		APIKey:                   d.APIKey,
+		WatchFolder:              d.WatchFolder,
+		RecentTVPriority:         d.RecentTVPriority,
+		IntialState:              d.IntialState,
+		InitialState:             d.InitialState,
+		OlderTVPriority:          d.OlderTVPriority,
+		Priority:                 d.Priority,
+		Port:                     d.Port,
+		ID:                       d.ID,
+		AddStopped:               d.AddStopped,
+		SaveMagnetFiles:          d.SaveMagnetFiles,
+		ReadOnly:                 d.ReadOnly,
+		FirstAndLast:             d.FirstAndLast,
+		SequentialOrder:          d.SequentialOrder,
+		StartOnAdd:               d.StartOnAdd,
+		UseSsl:                   d.UseSsl,
+		AddPaused:                d.AddPaused,
+		Enable:                   d.Enable,
+		RemoveFailedDownloads:    d.RemoveFailedDownloads,
+		RemoveCompletedDownloads: d.RemoveCompletedDownloads,
+	}
+}
+
+func (d *ManagedDownloadClient) fromDownloadClient(client *DownloadClient) {
+	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
+	d.PostImportTags = client.PostImportTags
+	d.FieldTags = client.FieldTags
+	d.AdditionalTags = client.AdditionalTags
+	d.NzbFolder = client.NzbFolder
+	d.Category = client.Category
+	d.Implementation = client.Implementation
+	d.Name = client.Name
+	d.Protocol = client.Protocol
+	d.MagnetFileExtension = client.MagnetFileExtension
+	d.TorrentFolder = client.TorrentFolder
+	d.StrmFolder = client.StrmFolder
+	d.Host = client.Host
+	d.ConfigContract = client.ConfigContract
+	d.Destination = client.Destination
+	d.MusicDirectory = client.MusicDirectory
+	d.TVDirectory = client.TVDirectory
+	d.Username = client.Username
+	d.MusicImportedCategory = client.MusicImportedCategory
+	d.MusicCategory = client.MusicCategory
+	d.Password = client.Password
+	d.SecretToken = client.SecretToken
+	d.RPCPath = client.RPCPath
+	d.URLBase = client.URLBase
+	d.APIKey = client.APIKey
+	d.WatchFolder = client.WatchFolder
+	d.RecentTVPriority = client.RecentTVPriority
+	d.IntialState = client.IntialState
+	d.InitialState = client.InitialState
+	d.OlderTVPriority = client.OlderTVPriority
+	d.Priority = client.Priority
+	d.Port = client.Port
+	d.ID = client.ID
+	d.AddStopped = client.AddStopped
+	d.SaveMagnetFiles = client.SaveMagnetFiles
+	d.ReadOnly = client.ReadOnly
+	d.FirstAndLast = client.FirstAndLast
+	d.SequentialOrder = client.SequentialOrder
+	d.StartOnAdd = client.StartOnAdd
+	d.UseSsl = client.UseSsl
+	d.AddPaused = client.AddPaused
+	d.Enable = client.Enable
+	d.RemoveFailedDownloads = client.RemoveFailedDownloads
+	d.RemoveCompletedDownloads = client.RemoveCompletedDownloads
+}
+
+func (d *ManagedDownloadClient) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
+	genericDownloadClient := d.toDownloadClient()
+	genericDownloadClient.write(ctx, downloadClient, diags)
+	d.fromDownloadClient(genericDownloadClient)
+}
+
+func (d *ManagedDownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *readarr.DownloadClientResource {
+	return d.toDownloadClient().read(ctx, diags)
+}
+
+// createDownloadClient creates a download client, or updates the existing one with the same name and implementation if adopt is set.
+func createDownloadClient(ctx context.Context, client *readarr.APIClient, request *readarr.DownloadClientResource, adopt bool) (*readarr.DownloadClientResource, error) {
+	return helpers.CreateOrAdopt(ctx, adopt, request.GetName(), downloadClientLookup(client, request.GetImplementation()),
+		func() (*readarr.DownloadClientResource, *http.Response, error) {
+			return client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+		},
+		func(id int32) (*readarr.DownloadClientResource, *http.Response, error) {
+			request.SetId(id)
+
+			return client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(id))).DownloadClientResource(*request).Execute()
+		},
+	)
+}
+
+// downloadClientStateMover returns the state mover from the generic download client resource to a typed one.
+func downloadClientStateMover(ctx context.Context, resourceName, implementation, configContract string, target interface{ fromDownloadClient(*DownloadClient) }) resource.StateMover {
+	return helpers.GenericStateMover(ctx, &DownloadClientResource{}, downloadClientResourceName, resourceName, implementation, configContract, func(client *ManagedDownloadClient) interface{} {
+		target.fromDownloadClient(client.toDownloadClient())
+
+		return target
+	})
+}
@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientSabnzbdResource{}
 	_ resource.ResourceWithImportState = &DownloadClientSabnzbdResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientSabnzbdResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientSabnzbdResource{}
 )
 
 func NewDownloadClientSabnzbdResource() resource.Resource {
@@ -37,12 +39,16 @@ func NewDownloadClientSabnzbdResource() resource.Resource {
 
 // DownloadClientSabnzbdResource defines the download client implementation.
 type DownloadClientSabnzbdResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientSabnzbd describes the download client data model.
 type DownloadClientSabnzbd struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -59,11 +65,13 @@ type DownloadClientSabnzbd struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientSabnzbd) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -88,6 +96,7 @@ func (d DownloadClientSabnzbd) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientSabnzbd) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -114,6 +123,10 @@ func (r *DownloadClientSabnzbdResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Sabnzbd resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [Sabnzbd](https://wiki.servarr.com/readarr/supported#sabnzbd).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -144,6 +157,8 @@ func (r *DownloadClientSabnzbdResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -215,11 +230,17 @@ func (r *DownloadClientSabnzbdResource) Schema(_ context.Context, _ resource.Sch
 }
 
 func (r *DownloadClientSabnzbdResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientSabnzbdResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientSabnzbdResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientSabnzbd
@@ -231,9 +252,15 @@ func (r *DownloadClientSabnzbdResource) Create(ctx context.Context, req resource
 	}
 
 	// Create new DownloadClientSabnzbd
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientSabnzbdResourceName, err))
 
@@ -243,6 +270,7 @@ func (r *DownloadClientSabnzbdResource) Create(ctx context.Context, req resource
 	tflog.Trace(ctx, "created "+downloadClientSabnzbdResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -264,9 +292,18 @@ func (r *DownloadClientSabnzbdResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientSabnzbdResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientSabnzbdImplementation, downloadClientSabnzbdConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientSabnzbdResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -281,7 +318,13 @@ func (r *DownloadClientSabnzbdResource) Update(ctx context.Context, req resource
 	}
 
 	// Update DownloadClientSabnzbd
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -293,6 +336,7 @@ func (r *DownloadClientSabnzbdResource) Update(ctx context.Context, req resource
 	tflog.Trace(ctx, "updated "+downloadClientSabnzbdResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -318,10 +362,16 @@ func (r *DownloadClientSabnzbdResource) Delete(ctx context.Context, req resource
 }
 
 func (r *DownloadClientSabnzbdResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientSabnzbdResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientSabnzbdImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientSabnzbdResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientSabnzbdResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientSabnzbdResourceName, downloadClientSabnzbdImplementation, downloadClientSabnzbdConfigContract, &DownloadClientSabnzbd{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientSabnzbd) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const downloadClientSchemaDataSourceName = "download_client_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DownloadClientSchemaDataSource{}
+
+func NewDownloadClientSchemaDataSource() datasource.DataSource {
+	return &DownloadClientSchemaDataSource{}
+}
+
+// DownloadClientSchemaDataSource defines the download client schema implementation.
+type DownloadClientSchemaDataSource struct {
+	client *readarr.APIClient
+}
+
+// DownloadClientSchema describes the download client schema data model.
+type DownloadClientSchema struct {
+	Implementations types.Set    `tfsdk:"implementations"`
+	ID              types.String `tfsdk:"id"`
+}
+
+func (d *DownloadClientSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + downloadClientSchemaDataSourceName
+}
+
+func (d *DownloadClientSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Download Clients -->List all available download client implementations with their fields, to be used with the generic [download client](../resources/download_client) resource.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"implementations": schema.SetNestedAttribute{
+				MarkdownDescription: "Implementation list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: implementationSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *DownloadClientSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *DownloadClientSchemaDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get download client schemas current value
+	response, _, err := d.client.DownloadClientApi.ListDownloadClientSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+downloadClientSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := make([]ImplementationSchema, len(response))
+	for i, s := range response {
+		schemas[i].write(ctx, s, &resp.Diagnostics)
+		schemas[i].Protocol = types.StringValue(string(s.GetProtocol()))
+	}
+
+	schemaList, diags := types.SetValueFrom(ctx, ImplementationSchema{}.getType(), schemas)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, DownloadClientSchema{Implementations: schemaList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDownloadClientSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccDownloadClientSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccDownloadClientSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_download_client_schema.test", "implementations.*", map[string]string{"implementation": "QBittorrent"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDownloadClientSchemaDataSourceConfig = `
+data "readarr_download_client_schema" "test" {
+}
+`
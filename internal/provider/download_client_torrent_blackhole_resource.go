@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientTorrentBlackholeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientTorrentBlackholeResource{}
 )
 
 func NewDownloadClientTorrentBlackholeResource() resource.Resource {
@@ -35,12 +37,17 @@ func NewDownloadClientTorrentBlackholeResource() resource.Resource {
 
 // DownloadClientTorrentBlackholeResource defines the download client implementation.
 type DownloadClientTorrentBlackholeResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	checkPaths        bool
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientTorrentBlackhole describes the download client data model.
 type DownloadClientTorrentBlackhole struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	TorrentFolder            types.String `tfsdk:"torrent_folder"`
 	WatchFolder              types.String `tfsdk:"watch_folder"`
@@ -52,11 +59,13 @@ type DownloadClientTorrentBlackhole struct {
 	ReadOnly                 types.Bool   `tfsdk:"read_only"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientTorrentBlackhole) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		TorrentFolder:            d.TorrentFolder,
 		WatchFolder:              d.WatchFolder,
@@ -76,6 +85,7 @@ func (d DownloadClientTorrentBlackhole) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientTorrentBlackhole) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.TorrentFolder = client.TorrentFolder
 	d.WatchFolder = client.WatchFolder
@@ -97,6 +107,10 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Torrent Blackhole resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [TorrentBlackhole](https://wiki.servarr.com/readarr/supported#torrentblackhole).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -127,6 +141,8 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -146,11 +162,11 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 				Computed:            true,
 			},
 			"torrent_folder": schema.StringAttribute{
-				MarkdownDescription: "Torrent folder.",
+				MarkdownDescription: "Torrent folder. Checked on the Readarr host if provider `check_paths` is set.",
 				Required:            true,
 			},
 			"watch_folder": schema.StringAttribute{
-				MarkdownDescription: "Watch folder flag.",
+				MarkdownDescription: "Watch folder. Checked on the Readarr host if provider `check_paths` is set.",
 				Required:            true,
 			},
 			"magnet_file_extension": schema.StringAttribute{
@@ -163,9 +179,20 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.checkPaths = data.CheckPaths
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
+	}
+}
+
+func (r *DownloadClientTorrentBlackholeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.checkPaths {
+		helpers.CheckPlanPaths(ctx, r.client, req, resp, "torrent_folder", "watch_folder")
 	}
+
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -179,9 +206,15 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 	}
 
 	// Create new DownloadClientTorrentBlackhole
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTorrentBlackholeResourceName, err))
 
@@ -191,6 +224,7 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 	tflog.Trace(ctx, "created "+downloadClientTorrentBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -212,9 +246,18 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientTorrentBlackholeResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientTorrentBlackholeImplementation, downloadClientTorrentBlackholeConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientTorrentBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -229,7 +272,13 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 	}
 
 	// Update DownloadClientTorrentBlackhole
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -241,6 +290,7 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 	tflog.Trace(ctx, "updated "+downloadClientTorrentBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -266,10 +316,16 @@ func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req
 }
 
 func (r *DownloadClientTorrentBlackholeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientTorrentBlackholeResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientTorrentBlackholeImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientTorrentBlackholeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientTorrentBlackholeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientTorrentBlackholeResourceName, downloadClientTorrentBlackholeImplementation, downloadClientTorrentBlackholeConfigContract, &DownloadClientTorrentBlackhole{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientTorrentBlackhole) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientTorrentDownloadStationResource{}
 	_ resource.ResourceWithImportState = &DownloadClientTorrentDownloadStationResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientTorrentDownloadStationResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientTorrentDownloadStationResource{}
 )
 
 func NewDownloadClientTorrentDownloadStationResource() resource.Resource {
@@ -35,12 +37,16 @@ func NewDownloadClientTorrentDownloadStationResource() resource.Resource {
 
 // DownloadClientTorrentDownloadStationResource defines the download client implementation.
 type DownloadClientTorrentDownloadStationResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientTorrentDownloadStation describes the download client data model.
 type DownloadClientTorrentDownloadStation struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	Username                 types.String `tfsdk:"username"`
@@ -54,11 +60,13 @@ type DownloadClientTorrentDownloadStation struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientTorrentDownloadStation) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		Username:                 d.Username,
@@ -80,6 +88,7 @@ func (d DownloadClientTorrentDownloadStation) toDownloadClient() *DownloadClient
 
 func (d *DownloadClientTorrentDownloadStation) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.Username = client.Username
@@ -103,6 +112,10 @@ func (r *DownloadClientTorrentDownloadStationResource) Schema(_ context.Context,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client TorrentDownloadStation resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [TorrentDownloadStation](https://wiki.servarr.com/readarr/supported#torrentdownloadstation).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -133,6 +146,8 @@ func (r *DownloadClientTorrentDownloadStationResource) Schema(_ context.Context,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -182,11 +197,17 @@ func (r *DownloadClientTorrentDownloadStationResource) Schema(_ context.Context,
 }
 
 func (r *DownloadClientTorrentDownloadStationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientTorrentDownloadStationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientTorrentDownloadStationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientTorrentDownloadStation
@@ -198,9 +219,15 @@ func (r *DownloadClientTorrentDownloadStationResource) Create(ctx context.Contex
 	}
 
 	// Create new DownloadClientTorrentDownloadStation
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTorrentDownloadStationResourceName, err))
 
@@ -210,6 +237,7 @@ func (r *DownloadClientTorrentDownloadStationResource) Create(ctx context.Contex
 	tflog.Trace(ctx, "created "+downloadClientTorrentDownloadStationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -231,9 +259,18 @@ func (r *DownloadClientTorrentDownloadStationResource) Read(ctx context.Context,
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientTorrentDownloadStationResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientTorrentDownloadStationImplementation, downloadClientTorrentDownloadStationConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientTorrentDownloadStationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -248,7 +285,13 @@ func (r *DownloadClientTorrentDownloadStationResource) Update(ctx context.Contex
 	}
 
 	// Update DownloadClientTorrentDownloadStation
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -260,6 +303,7 @@ func (r *DownloadClientTorrentDownloadStationResource) Update(ctx context.Contex
 	tflog.Trace(ctx, "updated "+downloadClientTorrentDownloadStationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -285,10 +329,16 @@ func (r *DownloadClientTorrentDownloadStationResource) Delete(ctx context.Contex
 }
 
 func (r *DownloadClientTorrentDownloadStationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientTorrentDownloadStationResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientTorrentDownloadStationImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientTorrentDownloadStationResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientTorrentDownloadStationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientTorrentDownloadStationResourceName, downloadClientTorrentDownloadStationImplementation, downloadClientTorrentDownloadStationConfigContract, &DownloadClientTorrentDownloadStation{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientTorrentDownloadStation) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDownloadClientTransmissionResource(t *testing.T) {
@@ -47,6 +50,58 @@ func TestAccDownloadClientTransmissionResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "readarr_download_client_transmission.test",
+				ImportState:       true,
+				ImportStateId:     "resourceTransmissionTest",
+				ImportStateVerify: true,
+			},
+			// ImportState into a wrong type testing
+			{
+				Config:       testAccDownloadClientTransmissionResourceConfig("resourceTransmissionTest", "true") + testAccDownloadClientQbittorrentResourceConfig("resourceTransmissionTest", "qbittorrent"),
+				ResourceName: "readarr_download_client_qbittorrent.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["readarr_download_client_transmission.test"].Primary.ID, nil
+				},
+				ExpectError: regexp.MustCompile("Implementation Mismatch"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDownloadClientTransmissionResourceMoveState(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create generic resource
+			{
+				Config: testAccDownloadClientResourceConfig("resourceTransmissionMove", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_download_client.test", "id"),
+				),
+			},
+			// Move to typed resource
+			{
+				Config: testAccDownloadClientTransmissionResourceMoveConfig("resourceTransmissionMove"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("readarr_download_client_transmission.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_download_client_transmission.test", "url_base", "/transmission/"),
+					resource.TestCheckResourceAttrSet("readarr_download_client_transmission.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -63,3 +118,22 @@ func testAccDownloadClientTransmissionResourceConfig(name, enable string) string
 		port = 9091
 	}`, enable, name)
 }
+
+func testAccDownloadClientTransmissionResourceMoveConfig(name string) string {
+	return fmt.Sprintf(`
+	moved {
+		from = readarr_download_client.test
+		to   = readarr_download_client_transmission.test
+	}
+
+	resource "readarr_download_client_transmission" "test" {
+		enable = false
+		remove_completed_downloads = false
+		remove_failed_downloads = false
+		priority = 1
+		name = "%s"
+		host = "transmission"
+		url_base = "/transmission/"
+		port = 9091
+	}`, name)
+}
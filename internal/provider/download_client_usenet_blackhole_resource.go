@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientUsenetBlackholeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientUsenetBlackholeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientUsenetBlackholeResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientUsenetBlackholeResource{}
 )
 
 func NewDownloadClientUsenetBlackholeResource() resource.Resource {
@@ -35,12 +37,16 @@ func NewDownloadClientUsenetBlackholeResource() resource.Resource {
 
 // DownloadClientUsenetBlackholeResource defines the download client implementation.
 type DownloadClientUsenetBlackholeResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientUsenetBlackhole describes the download client data model.
 type DownloadClientUsenetBlackhole struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	WatchFolder              types.String `tfsdk:"watch_folder"`
@@ -49,11 +55,13 @@ type DownloadClientUsenetBlackhole struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientUsenetBlackhole) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		NzbFolder:                d.NzbFolder,
 		WatchFolder:              d.WatchFolder,
@@ -70,6 +78,7 @@ func (d DownloadClientUsenetBlackhole) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientUsenetBlackhole) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.NzbFolder = client.NzbFolder
 	d.WatchFolder = client.WatchFolder
@@ -88,6 +97,10 @@ func (r *DownloadClientUsenetBlackholeResource) Schema(_ context.Context, _ reso
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Usenet Blackhole resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [UsenetBlackhole](https://wiki.servarr.com/readarr/supported#usenetblackhole).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -118,6 +131,8 @@ func (r *DownloadClientUsenetBlackholeResource) Schema(_ context.Context, _ reso
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -139,11 +154,17 @@ func (r *DownloadClientUsenetBlackholeResource) Schema(_ context.Context, _ reso
 }
 
 func (r *DownloadClientUsenetBlackholeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientUsenetBlackholeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientUsenetBlackhole
@@ -155,9 +176,15 @@ func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req
 	}
 
 	// Create new DownloadClientUsenetBlackhole
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUsenetBlackholeResourceName, err))
 
@@ -167,6 +194,7 @@ func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req
 	tflog.Trace(ctx, "created "+downloadClientUsenetBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -188,9 +216,18 @@ func (r *DownloadClientUsenetBlackholeResource) Read(ctx context.Context, req re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientUsenetBlackholeResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientUsenetBlackholeImplementation, downloadClientUsenetBlackholeConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientUsenetBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -205,7 +242,13 @@ func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req
 	}
 
 	// Update DownloadClientUsenetBlackhole
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -217,6 +260,7 @@ func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req
 	tflog.Trace(ctx, "updated "+downloadClientUsenetBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -242,10 +286,16 @@ func (r *DownloadClientUsenetBlackholeResource) Delete(ctx context.Context, req
 }
 
 func (r *DownloadClientUsenetBlackholeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientUsenetBlackholeResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientUsenetBlackholeImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientUsenetBlackholeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientUsenetBlackholeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientUsenetBlackholeResourceName, downloadClientUsenetBlackholeImplementation, downloadClientUsenetBlackholeConfigContract, &DownloadClientUsenetBlackhole{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientUsenetBlackhole) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
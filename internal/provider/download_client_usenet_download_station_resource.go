@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientUsenetDownloadStationResource{}
 	_ resource.ResourceWithImportState = &DownloadClientUsenetDownloadStationResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientUsenetDownloadStationResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientUsenetDownloadStationResource{}
 )
 
 func NewDownloadClientUsenetDownloadStationResource() resource.Resource {
@@ -35,12 +37,16 @@ func NewDownloadClientUsenetDownloadStationResource() resource.Resource {
 
 // DownloadClientUsenetDownloadStationResource defines the download client implementation.
 type DownloadClientUsenetDownloadStationResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // DownloadClientUsenetDownloadStation describes the download client data model.
 type DownloadClientUsenetDownloadStation struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	Username                 types.String `tfsdk:"username"`
@@ -54,11 +60,13 @@ type DownloadClientUsenetDownloadStation struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (d DownloadClientUsenetDownloadStation) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		Username:                 d.Username,
@@ -80,6 +88,7 @@ func (d DownloadClientUsenetDownloadStation) toDownloadClient() *DownloadClient
 
 func (d *DownloadClientUsenetDownloadStation) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.Username = client.Username
@@ -103,6 +112,10 @@ func (r *DownloadClientUsenetDownloadStationResource) Schema(_ context.Context,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client UsenetDownloadStation resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/readarr/settings#download-clients) and [UsenetDownloadStation](https://wiki.servarr.com/readarr/supported#usenetdownloadstation).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing download client with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -133,6 +146,8 @@ func (r *DownloadClientUsenetDownloadStationResource) Schema(_ context.Context,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -182,11 +197,17 @@ func (r *DownloadClientUsenetDownloadStationResource) Schema(_ context.Context,
 }
 
 func (r *DownloadClientUsenetDownloadStationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *DownloadClientUsenetDownloadStationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *DownloadClientUsenetDownloadStationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientUsenetDownloadStation
@@ -198,9 +219,15 @@ func (r *DownloadClientUsenetDownloadStationResource) Create(ctx context.Context
 	}
 
 	// Create new DownloadClientUsenetDownloadStation
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	response, err := createDownloadClient(ctx, r.client, request, client.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUsenetDownloadStationResourceName, err))
 
@@ -210,6 +237,7 @@ func (r *DownloadClientUsenetDownloadStationResource) Create(ctx context.Context
 	tflog.Trace(ctx, "created "+downloadClientUsenetDownloadStationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -231,9 +259,18 @@ func (r *DownloadClientUsenetDownloadStationResource) Read(ctx context.Context,
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(downloadClientUsenetDownloadStationResourceName, response.GetImplementation(), response.GetConfigContract(), downloadClientUsenetDownloadStationImplementation, downloadClientUsenetDownloadStationConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientUsenetDownloadStationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -248,7 +285,13 @@ func (r *DownloadClientUsenetDownloadStationResource) Update(ctx context.Context
 	}
 
 	// Update DownloadClientUsenetDownloadStation
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, client.Tags)
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, client.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
@@ -260,6 +303,7 @@ func (r *DownloadClientUsenetDownloadStationResource) Update(ctx context.Context
 	tflog.Trace(ctx, "updated "+downloadClientUsenetDownloadStationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &client.Tags, &client.TagLabels, &client.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -285,10 +329,16 @@ func (r *DownloadClientUsenetDownloadStationResource) Delete(ctx context.Context
 }
 
 func (r *DownloadClientUsenetDownloadStationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, downloadClientUsenetDownloadStationResourceName, path.Root("id"), req, resp, downloadClientLookup(r.client, downloadClientUsenetDownloadStationImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientUsenetDownloadStationResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientUsenetDownloadStationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		downloadClientStateMover(ctx, downloadClientUsenetDownloadStationResourceName, downloadClientUsenetDownloadStationImplementation, downloadClientUsenetDownloadStationConfigContract, &DownloadClientUsenetDownloadStation{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (d *DownloadClientUsenetDownloadStation) write(ctx context.Context, downloadClient *readarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
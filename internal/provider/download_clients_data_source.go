@@ -87,6 +87,11 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Download Client ID.",
 							Computed:            true,
@@ -254,11 +259,20 @@ func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadR
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientsDataSourceName)
 	// Map response body to resource schema attribute
 	clients := make([]DownloadClient, len(response))
 	for i, d := range response {
 		clients[i].write(ctx, d, &resp.Diagnostics)
+		clients[i].TagLabels = helpers.TagLabels(ctx, tagList, clients[i].Tags, &resp.Diagnostics)
 	}
 
 	clientList, diags := types.SetValueFrom(ctx, DownloadClient{}.getType(), clients)
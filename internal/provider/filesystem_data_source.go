@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const filesystemDataSourceName = "filesystem"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FilesystemDataSource{}
+
+func NewFilesystemDataSource() datasource.DataSource {
+	return &FilesystemDataSource{}
+}
+
+// FilesystemDataSource defines the filesystem implementation.
+type FilesystemDataSource struct {
+	client *readarr.APIClient
+}
+
+// Filesystem describes the filesystem data model.
+type Filesystem struct {
+	Directories  types.Set    `tfsdk:"directories"`
+	Files        types.Set    `tfsdk:"files"`
+	ID           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Parent       types.String `tfsdk:"parent"`
+	IncludeFiles types.Bool   `tfsdk:"include_files"`
+	Exists       types.Bool   `tfsdk:"exists"`
+}
+
+// FilesystemEntry is part of Filesystem.
+type FilesystemEntry struct {
+	Name         types.String `tfsdk:"name"`
+	Path         types.String `tfsdk:"path"`
+	Type         types.String `tfsdk:"type"`
+	LastModified types.String `tfsdk:"last_modified"`
+	Size         types.Int64  `tfsdk:"size"`
+}
+
+func (e FilesystemEntry) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":          types.StringType,
+			"path":          types.StringType,
+			"type":          types.StringType,
+			"last_modified": types.StringType,
+			"size":          types.Int64Type,
+		})
+}
+
+func (d *FilesystemDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + filesystemDataSourceName
+}
+
+func (d *FilesystemDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	entry := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Entry name.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Entry path.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Entry type.",
+				Computed:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "Last modification time.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->Browse a path on the Readarr host.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Absolute path to browse.",
+				Required:            true,
+			},
+			"include_files": schema.BoolAttribute{
+				MarkdownDescription: "Include files in the result. Defaults to `false`.",
+				Optional:            true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Path existence flag.",
+				Computed:            true,
+			},
+			"parent": schema.StringAttribute{
+				MarkdownDescription: "Parent path.",
+				Computed:            true,
+			},
+			"directories": schema.SetNestedAttribute{
+				MarkdownDescription: "Directory list.",
+				Computed:            true,
+				NestedObject:        entry,
+			},
+			"files": schema.SetNestedAttribute{
+				MarkdownDescription: "File list.",
+				Computed:            true,
+				NestedObject:        entry,
+			},
+		},
+	}
+}
+
+func (d *FilesystemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *FilesystemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var filesystem *Filesystem
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &filesystem)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get filesystem current value
+	exists, err := helpers.PathExists(ctx, d.client, filesystem.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, filesystemDataSourceName, err))
+
+		return
+	}
+
+	content := &helpers.FileSystemContent{}
+	if exists {
+		content, err = helpers.ListFileSystem(ctx, d.client, filesystem.Path.ValueString(), filesystem.IncludeFiles.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, filesystemDataSourceName, err))
+
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "read "+filesystemDataSourceName)
+	// Map response body to resource schema attribute
+	filesystem.write(ctx, content, exists, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &filesystem)...)
+}
+
+func (f *Filesystem) write(ctx context.Context, content *helpers.FileSystemContent, exists bool, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	f.ID = f.Path
+	f.Exists = types.BoolValue(exists)
+	f.Parent = types.StringValue(content.Parent)
+	f.Directories, tempDiag = types.SetValueFrom(ctx, FilesystemEntry{}.getType(), writeFilesystemEntries(content.Directories))
+	diags.Append(tempDiag...)
+	f.Files, tempDiag = types.SetValueFrom(ctx, FilesystemEntry{}.getType(), writeFilesystemEntries(content.Files))
+	diags.Append(tempDiag...)
+}
+
+func writeFilesystemEntries(entries []*helpers.FileSystemEntry) []FilesystemEntry {
+	output := make([]FilesystemEntry, len(entries))
+	for i, e := range entries {
+		output[i].write(e)
+	}
+
+	return output
+}
+
+func (e *FilesystemEntry) write(entry *helpers.FileSystemEntry) {
+	e.Name = types.StringValue(entry.Name)
+	e.Path = types.StringValue(entry.Path)
+	e.Type = types.StringValue(entry.Type)
+	e.Size = types.Int64Value(entry.Size)
+	e.LastModified = types.StringValue("")
+
+	if entry.LastModified != nil {
+		e.LastModified = types.StringValue(entry.LastModified.String())
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFilesystemDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccFilesystemDataSourceConfig("/config") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccFilesystemDataSourceConfig("/config"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_filesystem.test", "exists", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_filesystem.test", "files.*", map[string]string{"name": "config.xml"}),
+				),
+			},
+			// Missing path
+			{
+				Config: testAccFilesystemDataSourceConfig("/config/missing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_filesystem.test", "exists", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFilesystemDataSourceConfig(path string) string {
+	return `
+	data "readarr_filesystem" "test" {
+		path = "` + path + `"
+		include_files = true
+	}`
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// implementationSchemaResource is the common interface of the provider schemas published by Readarr.
+type implementationSchemaResource interface {
+	GetImplementation() string
+	GetImplementationName() string
+	GetConfigContract() string
+	GetInfoLink() string
+	GetFields() []*readarr.Field
+}
+
+// ImplementationSchema describes the implementation schema data model.
+type ImplementationSchema struct {
+	Fields             types.List   `tfsdk:"fields"`
+	Implementation     types.String `tfsdk:"implementation"`
+	ImplementationName types.String `tfsdk:"implementation_name"`
+	ConfigContract     types.String `tfsdk:"config_contract"`
+	Protocol           types.String `tfsdk:"protocol"`
+	InfoLink           types.String `tfsdk:"info_link"`
+}
+
+func (s ImplementationSchema) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"fields":              types.ListType{}.WithElementType(ImplementationSchemaField{}.getType()),
+			"implementation":      types.StringType,
+			"implementation_name": types.StringType,
+			"config_contract":     types.StringType,
+			"protocol":            types.StringType,
+			"info_link":           types.StringType,
+		})
+}
+
+// ImplementationSchemaField describes the implementation schema field data model.
+type ImplementationSchemaField struct {
+	SelectOptions types.List   `tfsdk:"select_options"`
+	Name          types.String `tfsdk:"name"`
+	Label         types.String `tfsdk:"label"`
+	Type          types.String `tfsdk:"type"`
+	Default       types.String `tfsdk:"default"`
+	HelpText      types.String `tfsdk:"help_text"`
+	Advanced      types.Bool   `tfsdk:"advanced"`
+}
+
+func (f ImplementationSchemaField) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"select_options": types.ListType{}.WithElementType(ImplementationSchemaSelectOption{}.getType()),
+			"name":           types.StringType,
+			"label":          types.StringType,
+			"type":           types.StringType,
+			"default":        types.StringType,
+			"help_text":      types.StringType,
+			"advanced":       types.BoolType,
+		})
+}
+
+// ImplementationSchemaSelectOption describes the implementation schema select option data model.
+type ImplementationSchemaSelectOption struct {
+	Name  types.String `tfsdk:"name"`
+	Hint  types.String `tfsdk:"hint"`
+	Value types.Int64  `tfsdk:"value"`
+}
+
+func (o ImplementationSchemaSelectOption) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"hint":  types.StringType,
+			"value": types.Int64Type,
+		})
+}
+
+// implementationSchemaAttributes returns the nested attributes of an implementation schema.
+func implementationSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"implementation": schema.StringAttribute{
+			MarkdownDescription: "Implementation.",
+			Computed:            true,
+		},
+		"implementation_name": schema.StringAttribute{
+			MarkdownDescription: "Implementation display name.",
+			Computed:            true,
+		},
+		"config_contract": schema.StringAttribute{
+			MarkdownDescription: "Configuration template.",
+			Computed:            true,
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol, only for download clients and indexers. Valid values are 'usenet' and 'torrent'.",
+			Computed:            true,
+		},
+		"info_link": schema.StringAttribute{
+			MarkdownDescription: "Link to the implementation documentation.",
+			Computed:            true,
+		},
+		"fields": schema.ListNestedAttribute{
+			MarkdownDescription: "Field definitions.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Field name, in camel case.",
+						Computed:            true,
+					},
+					"label": schema.StringAttribute{
+						MarkdownDescription: "Field label.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Field type.",
+						Computed:            true,
+					},
+					"default": schema.StringAttribute{
+						MarkdownDescription: "Default value, JSON encoded unless it is a string.",
+						Computed:            true,
+					},
+					"help_text": schema.StringAttribute{
+						MarkdownDescription: "Help text.",
+						Computed:            true,
+					},
+					"advanced": schema.BoolAttribute{
+						MarkdownDescription: "Advanced flag.",
+						Computed:            true,
+					},
+					"select_options": schema.ListNestedAttribute{
+						MarkdownDescription: "Select options.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.Int64Attribute{
+									MarkdownDescription: "Option value.",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Option name.",
+									Computed:            true,
+								},
+								"hint": schema.StringAttribute{
+									MarkdownDescription: "Option hint.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *ImplementationSchema) write(ctx context.Context, resource implementationSchemaResource, diags *diag.Diagnostics) {
+	s.Implementation = types.StringValue(resource.GetImplementation())
+	s.ImplementationName = types.StringValue(resource.GetImplementationName())
+	s.ConfigContract = types.StringValue(resource.GetConfigContract())
+	s.InfoLink = types.StringValue(resource.GetInfoLink())
+	s.Protocol = types.StringNull()
+
+	fields := make([]ImplementationSchemaField, len(resource.GetFields()))
+	for i, f := range resource.GetFields() {
+		fields[i].write(ctx, f, diags)
+	}
+
+	var tempDiag diag.Diagnostics
+
+	s.Fields, tempDiag = types.ListValueFrom(ctx, ImplementationSchemaField{}.getType(), fields)
+	diags.Append(tempDiag...)
+}
+
+func (f *ImplementationSchemaField) write(ctx context.Context, field *readarr.Field, diags *diag.Diagnostics) {
+	f.Name = types.StringValue(field.GetName())
+	f.Label = types.StringValue(field.GetLabel())
+	f.Type = types.StringValue(field.GetType())
+	f.HelpText = types.StringValue(field.GetHelpText())
+	f.Advanced = types.BoolValue(field.GetAdvanced())
+	f.Default = fieldDefault(field.GetValue(), diags)
+
+	options := make([]ImplementationSchemaSelectOption, len(field.GetSelectOptions()))
+	for i, o := range field.GetSelectOptions() {
+		options[i].Value = types.Int64Value(int64(o.GetValue()))
+		options[i].Name = types.StringValue(o.GetName())
+		options[i].Hint = types.StringValue(o.GetHint())
+	}
+
+	var tempDiag diag.Diagnostics
+
+	f.SelectOptions, tempDiag = types.ListValueFrom(ctx, ImplementationSchemaSelectOption{}.getType(), options)
+	diags.Append(tempDiag...)
+}
+
+// fieldDefault returns the default value of a field as a string, encoding non string values as JSON.
+func fieldDefault(value interface{}, diags *diag.Diagnostics) types.String {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			diags.AddError(helpers.DataSourceError, err.Error())
+
+			return types.StringNull()
+		}
+
+		return types.StringValue(string(encoded))
+	}
+}
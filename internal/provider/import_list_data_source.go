@@ -92,6 +92,11 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -179,6 +184,7 @@ func (d *ImportListDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	helpers.WriteTagLabels(ctx, d.client, data.Tags, &data.TagLabels, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+importListDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListGoodreadsBookshelfResource{}
 	_ resource.ResourceWithImportState = &ImportListGoodreadsBookshelfResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListGoodreadsBookshelfResource{}
+	_ resource.ResourceWithMoveState   = &ImportListGoodreadsBookshelfResource{}
 )
 
 func NewImportListGoodreadsBookshelfResource() resource.Resource {
@@ -37,13 +39,17 @@ func NewImportListGoodreadsBookshelfResource() resource.Resource {
 
 // ImportListGoodreadsBookshelfResource defines the import list implementation.
 type ImportListGoodreadsBookshelfResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ImportListGoodreadsBookshelf describes the import list data model.
 type ImportListGoodreadsBookshelf struct {
 	BookshelfIds          types.Set    `tfsdk:"bookshelf_ids"`
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Name                  types.String `tfsdk:"name"`
 	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
 	ShouldMonitor         types.String `tfsdk:"should_monitor"`
@@ -60,12 +66,14 @@ type ImportListGoodreadsBookshelf struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (i ImportListGoodreadsBookshelf) toImportList() *ImportList {
 	return &ImportList{
 		BookshelfIds:          i.BookshelfIds,
 		Tags:                  i.Tags,
+		TagLabels:             i.TagLabels,
 		Name:                  i.Name,
 		MonitorNewItems:       i.MonitorNewItems,
 		ShouldMonitor:         i.ShouldMonitor,
@@ -91,6 +99,7 @@ func (i ImportListGoodreadsBookshelf) toImportList() *ImportList {
 func (i *ImportListGoodreadsBookshelf) fromImportList(importList *ImportList) {
 	i.BookshelfIds = importList.BookshelfIds
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.MonitorNewItems = importList.MonitorNewItems
 	i.ShouldMonitor = importList.ShouldMonitor
@@ -117,6 +126,10 @@ func (r *ImportListGoodreadsBookshelfResource) Schema(_ context.Context, _ resou
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Goodreads Bookshelf resource.\nFor more information refer to [Import List](https://wiki.servarr.com/readarr/settings#import-lists) and [Goodreads Bookshelf](https://wiki.servarr.com/readarr/supported#goodreadsbookshelf).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing import list with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -178,6 +191,8 @@ func (r *ImportListGoodreadsBookshelfResource) Schema(_ context.Context, _ resou
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -222,11 +237,17 @@ func (r *ImportListGoodreadsBookshelfResource) Schema(_ context.Context, _ resou
 }
 
 func (r *ImportListGoodreadsBookshelfResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ImportListGoodreadsBookshelfResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ImportListGoodreadsBookshelfResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListGoodreadsBookshelf
@@ -238,9 +259,15 @@ func (r *ImportListGoodreadsBookshelfResource) Create(ctx context.Context, req r
 	}
 
 	// Create new ImportListGoodreadsBookshelf
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+	response, err := createImportList(ctx, r.client, request, importList.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListGoodreadsBookshelfResourceName, err))
 
@@ -250,6 +277,7 @@ func (r *ImportListGoodreadsBookshelfResource) Create(ctx context.Context, req r
 	tflog.Trace(ctx, "created "+importListGoodreadsBookshelfResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -271,9 +299,18 @@ func (r *ImportListGoodreadsBookshelfResource) Read(ctx context.Context, req res
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(importListGoodreadsBookshelfResourceName, response.GetImplementation(), response.GetConfigContract(), importListGoodreadsBookshelfImplementation, importListGoodreadsBookshelfConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+importListGoodreadsBookshelfResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -288,7 +325,13 @@ func (r *ImportListGoodreadsBookshelfResource) Update(ctx context.Context, req r
 	}
 
 	// Update ImportListGoodreadsBookshelf
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
@@ -300,6 +343,7 @@ func (r *ImportListGoodreadsBookshelfResource) Update(ctx context.Context, req r
 	tflog.Trace(ctx, "updated "+importListGoodreadsBookshelfResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -325,10 +369,16 @@ func (r *ImportListGoodreadsBookshelfResource) Delete(ctx context.Context, req r
 }
 
 func (r *ImportListGoodreadsBookshelfResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, importListGoodreadsBookshelfResourceName, path.Root("id"), req, resp, importListLookup(r.client, importListGoodreadsBookshelfImplementation))
 	tflog.Trace(ctx, "imported "+importListGoodreadsBookshelfResourceName+": "+req.ID)
 }
 
+func (r *ImportListGoodreadsBookshelfResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		importListStateMover(ctx, importListGoodreadsBookshelfResourceName, importListGoodreadsBookshelfImplementation, importListGoodreadsBookshelfConfigContract, &ImportListGoodreadsBookshelf{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *ImportListGoodreadsBookshelf) write(ctx context.Context, importList *readarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
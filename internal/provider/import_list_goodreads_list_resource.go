@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListGoodreadsListResource{}
 	_ resource.ResourceWithImportState = &ImportListGoodreadsListResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListGoodreadsListResource{}
+	_ resource.ResourceWithMoveState   = &ImportListGoodreadsListResource{}
 )
 
 func NewImportListGoodreadsListResource() resource.Resource {
@@ -37,12 +39,16 @@ func NewImportListGoodreadsListResource() resource.Resource {
 
 // ImportListGoodreadsListResource defines the import list implementation.
 type ImportListGoodreadsListResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ImportListGoodreadsList describes the import list data model.
 type ImportListGoodreadsList struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Name                  types.String `tfsdk:"name"`
 	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
 	ShouldMonitor         types.String `tfsdk:"should_monitor"`
@@ -55,11 +61,13 @@ type ImportListGoodreadsList struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (i ImportListGoodreadsList) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                  i.Tags,
+		TagLabels:             i.TagLabels,
 		Name:                  i.Name,
 		MonitorNewItems:       i.MonitorNewItems,
 		ShouldMonitor:         i.ShouldMonitor,
@@ -80,6 +88,7 @@ func (i ImportListGoodreadsList) toImportList() *ImportList {
 
 func (i *ImportListGoodreadsList) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.MonitorNewItems = importList.MonitorNewItems
 	i.ShouldMonitor = importList.ShouldMonitor
@@ -102,6 +111,10 @@ func (r *ImportListGoodreadsListResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Goodreads List resource.\nFor more information refer to [Import List](https://wiki.servarr.com/readarr/settings#import-lists) and [Goodreads List](https://wiki.servarr.com/readarr/supported#goodreadslist).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing import list with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -163,6 +176,8 @@ func (r *ImportListGoodreadsListResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -180,11 +195,17 @@ func (r *ImportListGoodreadsListResource) Schema(_ context.Context, _ resource.S
 }
 
 func (r *ImportListGoodreadsListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ImportListGoodreadsListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ImportListGoodreadsListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListGoodreadsList
@@ -196,9 +217,15 @@ func (r *ImportListGoodreadsListResource) Create(ctx context.Context, req resour
 	}
 
 	// Create new ImportListGoodreadsList
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+	response, err := createImportList(ctx, r.client, request, importList.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListGoodreadsListResourceName, err))
 
@@ -208,6 +235,7 @@ func (r *ImportListGoodreadsListResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "created "+importListGoodreadsListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -229,9 +257,18 @@ func (r *ImportListGoodreadsListResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(importListGoodreadsListResourceName, response.GetImplementation(), response.GetConfigContract(), importListGoodreadsListImplementation, importListGoodreadsListConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+importListGoodreadsListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -246,7 +283,13 @@ func (r *ImportListGoodreadsListResource) Update(ctx context.Context, req resour
 	}
 
 	// Update ImportListGoodreadsList
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
@@ -258,6 +301,7 @@ func (r *ImportListGoodreadsListResource) Update(ctx context.Context, req resour
 	tflog.Trace(ctx, "updated "+importListGoodreadsListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -283,10 +327,16 @@ func (r *ImportListGoodreadsListResource) Delete(ctx context.Context, req resour
 }
 
 func (r *ImportListGoodreadsListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, importListGoodreadsListResourceName, path.Root("id"), req, resp, importListLookup(r.client, importListGoodreadsListImplementation))
 	tflog.Trace(ctx, "imported "+importListGoodreadsListResourceName+": "+req.ID)
 }
 
+func (r *ImportListGoodreadsListResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		importListStateMover(ctx, importListGoodreadsListResourceName, importListGoodreadsListImplementation, importListGoodreadsListConfigContract, &ImportListGoodreadsList{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *ImportListGoodreadsList) write(ctx context.Context, importList *readarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListGoodreadsOwnedBooksResource{}
 	_ resource.ResourceWithImportState = &ImportListGoodreadsOwnedBooksResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListGoodreadsOwnedBooksResource{}
+	_ resource.ResourceWithMoveState   = &ImportListGoodreadsOwnedBooksResource{}
 )
 
 func NewImportListGoodreadsOwnedBooksResource() resource.Resource {
@@ -37,12 +39,16 @@ func NewImportListGoodreadsOwnedBooksResource() resource.Resource {
 
 // ImportListGoodreadsOwnedBooksResource defines the import list implementation.
 type ImportListGoodreadsOwnedBooksResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ImportListGoodreadsOwnedBooks describes the import list data model.
 type ImportListGoodreadsOwnedBooks struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Name                  types.String `tfsdk:"name"`
 	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
 	ShouldMonitor         types.String `tfsdk:"should_monitor"`
@@ -59,11 +65,13 @@ type ImportListGoodreadsOwnedBooks struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (i ImportListGoodreadsOwnedBooks) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                  i.Tags,
+		TagLabels:             i.TagLabels,
 		Name:                  i.Name,
 		MonitorNewItems:       i.MonitorNewItems,
 		ShouldMonitor:         i.ShouldMonitor,
@@ -88,6 +96,7 @@ func (i ImportListGoodreadsOwnedBooks) toImportList() *ImportList {
 
 func (i *ImportListGoodreadsOwnedBooks) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.MonitorNewItems = importList.MonitorNewItems
 	i.ShouldMonitor = importList.ShouldMonitor
@@ -114,6 +123,10 @@ func (r *ImportListGoodreadsOwnedBooksResource) Schema(_ context.Context, _ reso
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Goodreads OwnedBooks resource.\nFor more information refer to [Import List](https://wiki.servarr.com/readarr/settings#import-lists) and [Goodreads OwnedBooks](https://wiki.servarr.com/readarr/supported#goodreadsownedbooks).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing import list with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -175,6 +188,8 @@ func (r *ImportListGoodreadsOwnedBooksResource) Schema(_ context.Context, _ reso
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -213,11 +228,17 @@ func (r *ImportListGoodreadsOwnedBooksResource) Schema(_ context.Context, _ reso
 }
 
 func (r *ImportListGoodreadsOwnedBooksResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ImportListGoodreadsOwnedBooksResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ImportListGoodreadsOwnedBooksResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListGoodreadsOwnedBooks
@@ -229,9 +250,15 @@ func (r *ImportListGoodreadsOwnedBooksResource) Create(ctx context.Context, req
 	}
 
 	// Create new ImportListGoodreadsOwnedBooks
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+	response, err := createImportList(ctx, r.client, request, importList.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListGoodreadsOwnedBooksResourceName, err))
 
@@ -241,6 +268,7 @@ func (r *ImportListGoodreadsOwnedBooksResource) Create(ctx context.Context, req
 	tflog.Trace(ctx, "created "+importListGoodreadsOwnedBooksResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -262,9 +290,18 @@ func (r *ImportListGoodreadsOwnedBooksResource) Read(ctx context.Context, req re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(importListGoodreadsOwnedBooksResourceName, response.GetImplementation(), response.GetConfigContract(), importListGoodreadsOwnedBooksImplementation, importListGoodreadsOwnedBooksConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+importListGoodreadsOwnedBooksResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -279,7 +316,13 @@ func (r *ImportListGoodreadsOwnedBooksResource) Update(ctx context.Context, req
 	}
 
 	// Update ImportListGoodreadsOwnedBooks
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
@@ -291,6 +334,7 @@ func (r *ImportListGoodreadsOwnedBooksResource) Update(ctx context.Context, req
 	tflog.Trace(ctx, "updated "+importListGoodreadsOwnedBooksResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -316,10 +360,16 @@ func (r *ImportListGoodreadsOwnedBooksResource) Delete(ctx context.Context, req
 }
 
 func (r *ImportListGoodreadsOwnedBooksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, importListGoodreadsOwnedBooksResourceName, path.Root("id"), req, resp, importListLookup(r.client, importListGoodreadsOwnedBooksImplementation))
 	tflog.Trace(ctx, "imported "+importListGoodreadsOwnedBooksResourceName+": "+req.ID)
 }
 
+func (r *ImportListGoodreadsOwnedBooksResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		importListStateMover(ctx, importListGoodreadsOwnedBooksResourceName, importListGoodreadsOwnedBooksImplementation, importListGoodreadsOwnedBooksConfigContract, &ImportListGoodreadsOwnedBooks{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *ImportListGoodreadsOwnedBooks) write(ctx context.Context, importList *readarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListGoodreadsSeriesResource{}
 	_ resource.ResourceWithImportState = &ImportListGoodreadsSeriesResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListGoodreadsSeriesResource{}
+	_ resource.ResourceWithMoveState   = &ImportListGoodreadsSeriesResource{}
 )
 
 func NewImportListGoodreadsSeriesResource() resource.Resource {
@@ -37,12 +39,16 @@ func NewImportListGoodreadsSeriesResource() resource.Resource {
 
 // ImportListGoodreadsSeriesResource defines the import list implementation.
 type ImportListGoodreadsSeriesResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ImportListGoodreadsSeries describes the import list data model.
 type ImportListGoodreadsSeries struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Name                  types.String `tfsdk:"name"`
 	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
 	ShouldMonitor         types.String `tfsdk:"should_monitor"`
@@ -55,11 +61,13 @@ type ImportListGoodreadsSeries struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (i ImportListGoodreadsSeries) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                  i.Tags,
+		TagLabels:             i.TagLabels,
 		Name:                  i.Name,
 		MonitorNewItems:       i.MonitorNewItems,
 		ShouldMonitor:         i.ShouldMonitor,
@@ -80,6 +88,7 @@ func (i ImportListGoodreadsSeries) toImportList() *ImportList {
 
 func (i *ImportListGoodreadsSeries) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.MonitorNewItems = importList.MonitorNewItems
 	i.ShouldMonitor = importList.ShouldMonitor
@@ -102,6 +111,10 @@ func (r *ImportListGoodreadsSeriesResource) Schema(_ context.Context, _ resource
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Goodreads Series resource.\nFor more information refer to [Import List](https://wiki.servarr.com/readarr/settings#import-lists) and [Goodreads Series](https://wiki.servarr.com/readarr/supported#goodreadsseries).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing import list with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -163,6 +176,8 @@ func (r *ImportListGoodreadsSeriesResource) Schema(_ context.Context, _ resource
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -180,11 +195,17 @@ func (r *ImportListGoodreadsSeriesResource) Schema(_ context.Context, _ resource
 }
 
 func (r *ImportListGoodreadsSeriesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ImportListGoodreadsSeriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ImportListGoodreadsSeriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListGoodreadsSeries
@@ -196,9 +217,15 @@ func (r *ImportListGoodreadsSeriesResource) Create(ctx context.Context, req reso
 	}
 
 	// Create new ImportListGoodreadsSeries
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+	response, err := createImportList(ctx, r.client, request, importList.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListGoodreadsSeriesResourceName, err))
 
@@ -208,6 +235,7 @@ func (r *ImportListGoodreadsSeriesResource) Create(ctx context.Context, req reso
 	tflog.Trace(ctx, "created "+importListGoodreadsSeriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -229,9 +257,18 @@ func (r *ImportListGoodreadsSeriesResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(importListGoodreadsSeriesResourceName, response.GetImplementation(), response.GetConfigContract(), importListGoodreadsSeriesImplementation, importListGoodreadsSeriesConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+importListGoodreadsSeriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -246,7 +283,13 @@ func (r *ImportListGoodreadsSeriesResource) Update(ctx context.Context, req reso
 	}
 
 	// Update ImportListGoodreadsSeries
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
@@ -258,6 +301,7 @@ func (r *ImportListGoodreadsSeriesResource) Update(ctx context.Context, req reso
 	tflog.Trace(ctx, "updated "+importListGoodreadsSeriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -283,10 +327,16 @@ func (r *ImportListGoodreadsSeriesResource) Delete(ctx context.Context, req reso
 }
 
 func (r *ImportListGoodreadsSeriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, importListGoodreadsSeriesResourceName, path.Root("id"), req, resp, importListLookup(r.client, importListGoodreadsSeriesImplementation))
 	tflog.Trace(ctx, "imported "+importListGoodreadsSeriesResourceName+": "+req.ID)
 }
 
+func (r *ImportListGoodreadsSeriesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		importListStateMover(ctx, importListGoodreadsSeriesResourceName, importListGoodreadsSeriesImplementation, importListGoodreadsSeriesConfigContract, &ImportListGoodreadsSeries{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *ImportListGoodreadsSeries) write(ctx context.Context, importList *readarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListLazyLibrarianResource{}
 	_ resource.ResourceWithImportState = &ImportListLazyLibrarianResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListLazyLibrarianResource{}
+	_ resource.ResourceWithMoveState   = &ImportListLazyLibrarianResource{}
 )
 
 func NewImportListLazyLibrarianResource() resource.Resource {
@@ -37,12 +39,16 @@ func NewImportListLazyLibrarianResource() resource.Resource {
 
 // ImportListLazyLibrarianResource defines the import list implementation.
 type ImportListLazyLibrarianResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ImportListLazyLibrarian describes the import list data model.
 type ImportListLazyLibrarian struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Name                  types.String `tfsdk:"name"`
 	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
 	BaseURL               types.String `tfsdk:"base_url"`
@@ -56,11 +62,13 @@ type ImportListLazyLibrarian struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (i ImportListLazyLibrarian) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                  i.Tags,
+		TagLabels:             i.TagLabels,
 		Name:                  i.Name,
 		MonitorNewItems:       i.MonitorNewItems,
 		ShouldMonitor:         i.ShouldMonitor,
@@ -82,6 +90,7 @@ func (i ImportListLazyLibrarian) toImportList() *ImportList {
 
 func (i *ImportListLazyLibrarian) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.MonitorNewItems = importList.MonitorNewItems
 	i.ShouldMonitor = importList.ShouldMonitor
@@ -105,6 +114,10 @@ func (r *ImportListLazyLibrarianResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Lazy Librarian resource.\nFor more information refer to [Import List](https://wiki.servarr.com/readarr/settings#import-lists) and [Lazy Librarian](https://wiki.servarr.com/readarr/supported#lazylibrarianimport).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing import list with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -166,6 +179,8 @@ func (r *ImportListLazyLibrarianResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -188,11 +203,17 @@ func (r *ImportListLazyLibrarianResource) Schema(_ context.Context, _ resource.S
 }
 
 func (r *ImportListLazyLibrarianResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ImportListLazyLibrarianResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ImportListLazyLibrarianResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListLazyLibrarian
@@ -204,9 +225,15 @@ func (r *ImportListLazyLibrarianResource) Create(ctx context.Context, req resour
 	}
 
 	// Create new ImportListLazyLibrarian
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+	response, err := createImportList(ctx, r.client, request, importList.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListLazyLibrarianResourceName, err))
 
@@ -216,6 +243,7 @@ func (r *ImportListLazyLibrarianResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "created "+importListLazyLibrarianResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -237,9 +265,18 @@ func (r *ImportListLazyLibrarianResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(importListLazyLibrarianResourceName, response.GetImplementation(), response.GetConfigContract(), importListLazyLibrarianImplementation, importListLazyLibrarianConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+importListLazyLibrarianResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -254,7 +291,13 @@ func (r *ImportListLazyLibrarianResource) Update(ctx context.Context, req resour
 	}
 
 	// Update ImportListLazyLibrarian
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
@@ -266,6 +309,7 @@ func (r *ImportListLazyLibrarianResource) Update(ctx context.Context, req resour
 	tflog.Trace(ctx, "updated "+importListLazyLibrarianResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -291,10 +335,16 @@ func (r *ImportListLazyLibrarianResource) Delete(ctx context.Context, req resour
 }
 
 func (r *ImportListLazyLibrarianResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, importListLazyLibrarianResourceName, path.Root("id"), req, resp, importListLookup(r.client, importListLazyLibrarianImplementation))
 	tflog.Trace(ctx, "imported "+importListLazyLibrarianResourceName+": "+req.ID)
 }
 
+func (r *ImportListLazyLibrarianResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		importListStateMover(ctx, importListLazyLibrarianResourceName, importListLazyLibrarianImplementation, importListLazyLibrarianConfigContract, &ImportListLazyLibrarian{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *ImportListLazyLibrarian) write(ctx context.Context, importList *readarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
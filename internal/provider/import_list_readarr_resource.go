@@ -29,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListReadarrResource{}
 	_ resource.ResourceWithImportState = &ImportListReadarrResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListReadarrResource{}
+	_ resource.ResourceWithMoveState   = &ImportListReadarrResource{}
 )
 
 func NewImportListReadarrResource() resource.Resource {
@@ -37,7 +39,9 @@ func NewImportListReadarrResource() resource.Resource {
 
 // ImportListReadarrResource defines the import list implementation.
 type ImportListReadarrResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ImportListReadarr describes the import list data model.
@@ -45,6 +49,8 @@ type ImportListReadarr struct {
 	ProfileIds            types.Set    `tfsdk:"profile_ids"`
 	TagIds                types.Set    `tfsdk:"tag_ids"`
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Name                  types.String `tfsdk:"name"`
 	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
 	ShouldMonitor         types.String `tfsdk:"should_monitor"`
@@ -58,6 +64,7 @@ type ImportListReadarr struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (i ImportListReadarr) toImportList() *ImportList {
@@ -65,6 +72,7 @@ func (i ImportListReadarr) toImportList() *ImportList {
 		ProfileIds:            i.ProfileIds,
 		TagIds:                i.TagIds,
 		Tags:                  i.Tags,
+		TagLabels:             i.TagLabels,
 		Name:                  i.Name,
 		MonitorNewItems:       i.MonitorNewItems,
 		ShouldMonitor:         i.ShouldMonitor,
@@ -88,6 +96,7 @@ func (i *ImportListReadarr) fromImportList(importList *ImportList) {
 	i.ProfileIds = importList.ProfileIds
 	i.TagIds = importList.TagIds
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.MonitorNewItems = importList.MonitorNewItems
 	i.ShouldMonitor = importList.ShouldMonitor
@@ -111,6 +120,10 @@ func (r *ImportListReadarrResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Readarr resource.\nFor more information refer to [Import List](https://wiki.servarr.com/readarr/settings#import-lists) and [Readarr](https://wiki.servarr.com/readarr/supported#readarrimport).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing import list with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -172,6 +185,8 @@ func (r *ImportListReadarrResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -206,11 +221,17 @@ func (r *ImportListReadarrResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *ImportListReadarrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ImportListReadarrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ImportListReadarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListReadarr
@@ -222,9 +243,15 @@ func (r *ImportListReadarrResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create new ImportListReadarr
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+	response, err := createImportList(ctx, r.client, request, importList.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListReadarrResourceName, err))
 
@@ -234,6 +261,7 @@ func (r *ImportListReadarrResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+importListReadarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -255,9 +283,18 @@ func (r *ImportListReadarrResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(importListReadarrResourceName, response.GetImplementation(), response.GetConfigContract(), importListReadarrImplementation, importListReadarrConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+importListReadarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -272,7 +309,13 @@ func (r *ImportListReadarrResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update ImportListReadarr
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
@@ -284,6 +327,7 @@ func (r *ImportListReadarrResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+importListReadarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &importList.Tags, &importList.TagLabels, &importList.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -309,10 +353,16 @@ func (r *ImportListReadarrResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ImportListReadarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, importListReadarrResourceName, path.Root("id"), req, resp, importListLookup(r.client, importListReadarrImplementation))
 	tflog.Trace(ctx, "imported "+importListReadarrResourceName+": "+req.ID)
 }
 
+func (r *ImportListReadarrResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		importListStateMover(ctx, importListReadarrResourceName, importListReadarrImplementation, importListReadarrConfigContract, &ImportListReadarr{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *ImportListReadarr) write(ctx context.Context, importList *readarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"strconv"
 
 	"github.com/devopsarr/readarr-go/readarr"
@@ -25,6 +26,7 @@ const importListResourceName = "import_list"
 var (
 	_ resource.Resource                = &ImportListResource{}
 	_ resource.ResourceWithImportState = &ImportListResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListResource{}
 )
 
 var importListFields = helpers.Fields{
@@ -40,7 +42,9 @@ func NewImportListResource() resource.Resource {
 
 // ImportListResource defines the download client implementation.
 type ImportListResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ImportList describes the download client data model.
@@ -49,6 +53,7 @@ type ImportList struct {
 	TagIds                types.Set    `tfsdk:"tag_ids"`
 	BookshelfIds          types.Set    `tfsdk:"bookshelf_ids"`
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
 	Name                  types.String `tfsdk:"name"`
 	ConfigContract        types.String `tfsdk:"config_contract"`
 	Implementation        types.String `tfsdk:"implementation"`
@@ -74,12 +79,47 @@ type ImportList struct {
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
 }
 
+// ManagedImportList describes the import list resource data model, the ImportList fields plus the resource options.
+type ManagedImportList struct {
+	ProfileIds            types.Set    `tfsdk:"profile_ids"`
+	TagIds                types.Set    `tfsdk:"tag_ids"`
+	BookshelfIds          types.Set    `tfsdk:"bookshelf_ids"`
+	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
+	Name                  types.String `tfsdk:"name"`
+	ConfigContract        types.String `tfsdk:"config_contract"`
+	Implementation        types.String `tfsdk:"implementation"`
+	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
+	AccessToken           types.String `tfsdk:"access_token"`
+	AccessTokenSecret     types.String `tfsdk:"access_token_secret"`
+	RequestTokenSecret    types.String `tfsdk:"request_token_secret"`
+	ShouldMonitor         types.String `tfsdk:"should_monitor"`
+	ListType              types.String `tfsdk:"list_type"`
+	RootFolderPath        types.String `tfsdk:"root_folder_path"`
+	BaseURL               types.String `tfsdk:"base_url"`
+	APIKey                types.String `tfsdk:"api_key"`
+	UserID                types.String `tfsdk:"user_id"`
+	Username              types.String `tfsdk:"username"`
+	ListID                types.Int64  `tfsdk:"list_id"`
+	SeriesID              types.Int64  `tfsdk:"series_id"`
+	QualityProfileID      types.Int64  `tfsdk:"quality_profile_id"`
+	MetadataProfileID     types.Int64  `tfsdk:"metadata_profile_id"`
+	ListOrder             types.Int64  `tfsdk:"list_order"`
+	ID                    types.Int64  `tfsdk:"id"`
+	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
+	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
+	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (i ImportList) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"bookshelf_ids":           types.SetType{}.WithElementType(types.StringType),
 			"tag_ids":                 types.SetType{}.WithElementType(types.Int64Type),
 			"tags":                    types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":              types.SetType{}.WithElementType(types.StringType),
 			"profile_ids":             types.SetType{}.WithElementType(types.Int64Type),
 			"name":                    types.StringType,
 			"config_contract":         types.StringType,
@@ -115,6 +155,10 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Generic Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/readarr/settings#import-lists).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing import list with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -192,6 +236,8 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -272,14 +318,20 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 }
 
 func (r *ImportListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ImportListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var importList *ImportList
+	var importList *ManagedImportList
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -288,9 +340,15 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Create new ImportList
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+	response, err := createImportList(ctx, r.client, request, importList.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
 
@@ -300,15 +358,17 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "created "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ManagedImportList
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = importList.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var importList *ImportList
+	var importList *ManagedImportList
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &importList)...)
 
@@ -327,15 +387,18 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Trace(ctx, "read "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ManagedImportList
 
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = importList.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var importList *ImportList
+	var importList *ManagedImportList
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -344,7 +407,13 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Update ImportList
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, importList.Tags)
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, importList.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
@@ -356,9 +425,11 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Trace(ctx, "updated "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ManagedImportList
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = importList.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -384,7 +455,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ImportListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, importListResourceName, path.Root("id"), req, resp, importListLookup(r.client, ""))
 	tflog.Trace(ctx, "imported "+importListResourceName+": "+req.ID)
 }
 
@@ -435,3 +506,118 @@ func (i *ImportList) read(ctx context.Context, diags *diag.Diagnostics) *readarr
 
 	return list
 }
+
+// importListLookup returns an import lookup by name for import lists, restricted to the given implementation if not empty.
+func importListLookup(client *readarr.APIClient, implementation string) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.ImportListApi.ListImportList(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name && (implementation == "" || item.GetImplementation() == implementation) {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
+
+func (i ManagedImportList) toImportList() *ImportList {
+	return &ImportList{
+		ProfileIds:            i.ProfileIds,
+		TagIds:                i.TagIds,
+		BookshelfIds:          i.BookshelfIds,
+		Tags:                  i.Tags,
+		TagLabels:             i.TagLabels,
+		Name:                  i.Name,
+		ConfigContract:        i.ConfigContract,
+		Implementation:        i.Implementation,
+		MonitorNewItems:       i.MonitorNewItems,
+		AccessToken:           i.AccessToken,
+		AccessTokenSecret:     i.AccessTokenSecret,
+		RequestTokenSecret:    i.RequestTokenSecret,
+		ShouldMonitor:         i.ShouldMonitor,
+		ListType:              i.ListType,
+		RootFolderPath:        i.RootFolderPath,
+		BaseURL:               i.BaseURL,
+		APIKey:                i.APIKey,
+		UserID:                i.UserID,
+		Username:              i.Username,
+		ListID:                i.ListID,
+		SeriesID:              i.SeriesID,
+		QualityProfileID:      i.QualityProfileID,
+		MetadataProfileID:     i.MetadataProfileID,
+		ListOrder:             i.ListOrder,
+		ID:                    i.ID,
+		EnableAutomaticAdd:    i.EnableAutomaticAdd,
+		ShouldMonitorExisting: i.ShouldMonitorExisting,
+		ShouldSearch:          i.ShouldSearch,
+	}
+}
+
+func (i *ManagedImportList) fromImportList(importList *ImportList) {
+	i.ProfileIds = importList.ProfileIds
+	i.TagIds = importList.TagIds
+	i.BookshelfIds = importList.BookshelfIds
+	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
+	i.Name = importList.Name
+	i.ConfigContract = importList.ConfigContract
+	i.Implementation = importList.Implementation
+	i.MonitorNewItems = importList.MonitorNewItems
+	i.AccessToken = importList.AccessToken
+	i.AccessTokenSecret = importList.AccessTokenSecret
+	i.RequestTokenSecret = importList.RequestTokenSecret
+	i.ShouldMonitor = importList.ShouldMonitor
+	i.ListType = importList.ListType
+	i.RootFolderPath = importList.RootFolderPath
+	i.BaseURL = importList.BaseURL
+	i.APIKey = importList.APIKey
+	i.UserID = importList.UserID
+	i.Username = importList.Username
+	i.ListID = importList.ListID
+	i.SeriesID = importList.SeriesID
+	i.QualityProfileID = importList.QualityProfileID
+	i.MetadataProfileID = importList.MetadataProfileID
+	i.ListOrder = importList.ListOrder
+	i.ID = importList.ID
+	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
+	i.ShouldMonitorExisting = importList.ShouldMonitorExisting
+	i.ShouldSearch = importList.ShouldSearch
+}
+
+func (i *ManagedImportList) write(ctx context.Context, importList *readarr.ImportListResource, diags *diag.Diagnostics) {
+	genericImportList := i.toImportList()
+	genericImportList.write(ctx, importList, diags)
+	i.fromImportList(genericImportList)
+}
+
+func (i *ManagedImportList) read(ctx context.Context, diags *diag.Diagnostics) *readarr.ImportListResource {
+	return i.toImportList().read(ctx, diags)
+}
+
+// createImportList creates an import list, or updates the existing one with the same name and implementation if adopt is set.
+func createImportList(ctx context.Context, client *readarr.APIClient, request *readarr.ImportListResource, adopt bool) (*readarr.ImportListResource, error) {
+	return helpers.CreateOrAdopt(ctx, adopt, request.GetName(), importListLookup(client, request.GetImplementation()),
+		func() (*readarr.ImportListResource, *http.Response, error) {
+			return client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
+		},
+		func(id int32) (*readarr.ImportListResource, *http.Response, error) {
+			request.SetId(id)
+
+			return client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(id))).ImportListResource(*request).Execute()
+		},
+	)
+}
+
+// importListStateMover returns the state mover from the generic import list resource to a typed one.
+func importListStateMover(ctx context.Context, resourceName, implementation, configContract string, target interface{ fromImportList(*ImportList) }) resource.StateMover {
+	return helpers.GenericStateMover(ctx, &ImportListResource{}, importListResourceName, resourceName, implementation, configContract, func(importList *ManagedImportList) interface{} {
+		target.fromImportList(importList.toImportList())
+
+		return target
+	})
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const importListSchemaDataSourceName = "import_list_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImportListSchemaDataSource{}
+
+func NewImportListSchemaDataSource() datasource.DataSource {
+	return &ImportListSchemaDataSource{}
+}
+
+// ImportListSchemaDataSource defines the import list schema implementation.
+type ImportListSchemaDataSource struct {
+	client *readarr.APIClient
+}
+
+// ImportListSchema describes the import list schema data model.
+type ImportListSchema struct {
+	Implementations types.Set    `tfsdk:"implementations"`
+	ID              types.String `tfsdk:"id"`
+}
+
+func (d *ImportListSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + importListSchemaDataSourceName
+}
+
+func (d *ImportListSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Import Lists -->List all available import list implementations with their fields, to be used with the generic [import list](../resources/import_list) resource.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"implementations": schema.SetNestedAttribute{
+				MarkdownDescription: "Implementation list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: implementationSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ImportListSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *ImportListSchemaDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get import list schemas current value
+	response, _, err := d.client.ImportListApi.ListImportListSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+importListSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := make([]ImplementationSchema, len(response))
+	for i, s := range response {
+		schemas[i].write(ctx, s, &resp.Diagnostics)
+	}
+
+	schemaList, diags := types.SetValueFrom(ctx, ImplementationSchema{}.getType(), schemas)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, ImportListSchema{Implementations: schemaList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImportListSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccImportListSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccImportListSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_import_list_schema.test", "implementations.*", map[string]string{"implementation": "ReadarrImport"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccImportListSchemaDataSourceConfig = `
+data "readarr_import_list_schema" "test" {
+}
+`
@@ -107,6 +107,11 @@ func (d *ImportListsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Import List ID.",
 							Computed:            true,
@@ -189,11 +194,20 @@ func (d *ImportListsDataSource) Read(ctx context.Context, _ datasource.ReadReque
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+importListsDataSourceName)
 	// Map response body to resource schema attribute
 	importLists := make([]ImportList, len(response))
 	for i, d := range response {
 		importLists[i].write(ctx, d, &resp.Diagnostics)
+		importLists[i].TagLabels = helpers.TagLabels(ctx, tagList, importLists[i].Tags, &resp.Diagnostics)
 	}
 
 	listList, diags := types.SetValueFrom(ctx, ImportList{}.getType(), importLists)
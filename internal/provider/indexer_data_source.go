@@ -72,6 +72,11 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -183,6 +188,7 @@ func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	helpers.WriteTagLabels(ctx, d.client, data.Tags, &data.TagLabels, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+indexerDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
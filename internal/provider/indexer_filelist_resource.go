@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerFilelistResource{}
 	_ resource.ResourceWithImportState = &IndexerFilelistResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerFilelistResource{}
+	_ resource.ResourceWithMoveState   = &IndexerFilelistResource{}
 )
 
 func NewIndexerFilelistResource() resource.Resource {
@@ -35,13 +37,17 @@ func NewIndexerFilelistResource() resource.Resource {
 
 // IndexerFilelistResource defines the Filelist indexer implementation.
 type IndexerFilelistResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerFilelist describes the Filelist indexer data model.
 type IndexerFilelist struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
+	TagsAll                 types.Set     `tfsdk:"tags_all"`
 	Categories              types.Set     `tfsdk:"categories"`
 	Username                types.String  `tfsdk:"username"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
@@ -56,6 +62,7 @@ type IndexerFilelist struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	AdoptExisting           types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerFilelist) toIndexer() *Indexer {
@@ -75,6 +82,7 @@ func (i IndexerFilelist) toIndexer() *Indexer {
 		Passkey:                 i.Passkey,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Categories:              i.Categories,
 		Implementation:          types.StringValue(indexerFilelistImplementation),
 		ConfigContract:          types.StringValue(indexerFilelistConfigContract),
@@ -98,6 +106,7 @@ func (i *IndexerFilelist) fromIndexer(indexer *Indexer) {
 	i.Passkey = indexer.Passkey
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.Categories = indexer.Categories
 }
 
@@ -109,6 +118,10 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer FileList resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [FileList](https://wiki.servarr.com/readarr/supported#filelist).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -139,6 +152,8 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerFilelist ID.",
 				Computed:            true,
@@ -197,11 +212,17 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 }
 
 func (r *IndexerFilelistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerFilelistResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerFilelist
@@ -213,9 +234,15 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Create new IndexerFilelist
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
 
@@ -225,6 +252,7 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 	tflog.Trace(ctx, "created "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -246,9 +274,18 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerFilelistResourceName, response.GetImplementation(), response.GetConfigContract(), indexerFilelistImplementation, indexerFilelistConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -263,7 +300,13 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Update IndexerFilelist
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -275,6 +318,7 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	tflog.Trace(ctx, "updated "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -300,10 +344,16 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *IndexerFilelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerFilelistResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerFilelistImplementation))
 	tflog.Trace(ctx, "imported "+indexerFilelistResourceName+": "+req.ID)
 }
 
+func (r *IndexerFilelistResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerFilelistResourceName, indexerFilelistImplementation, indexerFilelistConfigContract, &IndexerFilelist{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerFilelist) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
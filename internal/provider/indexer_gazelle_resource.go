@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerGazelleResource{}
 	_ resource.ResourceWithImportState = &IndexerGazelleResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerGazelleResource{}
+	_ resource.ResourceWithMoveState   = &IndexerGazelleResource{}
 )
 
 func NewIndexerGazelleResource() resource.Resource {
@@ -35,13 +37,17 @@ func NewIndexerGazelleResource() resource.Resource {
 
 // IndexerGazelleResource defines the Gazelle indexer implementation.
 type IndexerGazelleResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerGazelle describes the Gazelle indexer data model.
 type IndexerGazelle struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
+	TagsAll                 types.Set     `tfsdk:"tags_all"`
 	Name                    types.String  `tfsdk:"name"`
 	Username                types.String  `tfsdk:"username"`
 	Password                types.String  `tfsdk:"password"`
@@ -55,6 +61,7 @@ type IndexerGazelle struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	AdoptExisting           types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerGazelle) toIndexer() *Indexer {
@@ -74,6 +81,7 @@ func (i IndexerGazelle) toIndexer() *Indexer {
 		Password:                i.Password,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Implementation:          types.StringValue(indexerGazelleImplementation),
 		ConfigContract:          types.StringValue(indexerGazelleConfigContract),
 		Protocol:                types.StringValue(indexerGazelleProtocol),
@@ -96,6 +104,7 @@ func (i *IndexerGazelle) fromIndexer(indexer *Indexer) {
 	i.Password = indexer.Password
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 }
 
 func (r *IndexerGazelleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -106,6 +115,10 @@ func (r *IndexerGazelleResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Gazelle resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [Gazelle](https://wiki.servarr.com/readarr/supported#gazelle).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -136,6 +149,8 @@ func (r *IndexerGazelleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerGazelle ID.",
 				Computed:            true,
@@ -188,11 +203,17 @@ func (r *IndexerGazelleResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *IndexerGazelleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerGazelleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerGazelleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerGazelle
@@ -204,9 +225,15 @@ func (r *IndexerGazelleResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Create new IndexerGazelle
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerGazelleResourceName, err))
 
@@ -216,6 +243,7 @@ func (r *IndexerGazelleResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+indexerGazelleResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -237,9 +265,18 @@ func (r *IndexerGazelleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerGazelleResourceName, response.GetImplementation(), response.GetConfigContract(), indexerGazelleImplementation, indexerGazelleConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerGazelleResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -254,7 +291,13 @@ func (r *IndexerGazelleResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update IndexerGazelle
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -266,6 +309,7 @@ func (r *IndexerGazelleResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+indexerGazelleResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -291,10 +335,16 @@ func (r *IndexerGazelleResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerGazelleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerGazelleResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerGazelleImplementation))
 	tflog.Trace(ctx, "imported "+indexerGazelleResourceName+": "+req.ID)
 }
 
+func (r *IndexerGazelleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerGazelleResourceName, indexerGazelleImplementation, indexerGazelleConfigContract, &IndexerGazelle{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerGazelle) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerIptorrentsResource{}
 	_ resource.ResourceWithImportState = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithMoveState   = &IndexerIptorrentsResource{}
 )
 
 func NewIndexerIptorrentsResource() resource.Resource {
@@ -35,13 +37,17 @@ func NewIndexerIptorrentsResource() resource.Resource {
 
 // IndexerIptorrentsResource defines the Iptorrents indexer implementation.
 type IndexerIptorrentsResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerIptorrents describes the Iptorrents indexer data model.
 type IndexerIptorrents struct {
 	SeedRatio           types.Float64 `tfsdk:"seed_ratio"`
 	Tags                types.Set     `tfsdk:"tags"`
+	TagLabels           types.Set     `tfsdk:"tag_labels"`
+	TagsAll             types.Set     `tfsdk:"tags_all"`
 	Name                types.String  `tfsdk:"name"`
 	BaseURL             types.String  `tfsdk:"base_url"`
 	Priority            types.Int64   `tfsdk:"priority"`
@@ -51,6 +57,7 @@ type IndexerIptorrents struct {
 	SeedTime            types.Int64   `tfsdk:"seed_time"`
 	DiscographySeedTime types.Int64   `tfsdk:"author_seed_time"`
 	EnableRss           types.Bool    `tfsdk:"enable_rss"`
+	AdoptExisting       types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerIptorrents) toIndexer() *Indexer {
@@ -66,6 +73,7 @@ func (i IndexerIptorrents) toIndexer() *Indexer {
 		SeedRatio:           i.SeedRatio,
 		BaseURL:             i.BaseURL,
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Implementation:      types.StringValue(indexerIptorrentsImplementation),
 		ConfigContract:      types.StringValue(indexerIptorrentsConfigContract),
 		Protocol:            types.StringValue(indexerIptorrentsProtocol),
@@ -84,6 +92,7 @@ func (i *IndexerIptorrents) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 }
 
 func (r *IndexerIptorrentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -94,6 +103,10 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer IP Torrents resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [IP Torrents](https://wiki.servarr.com/readarr/supported#iptorrents).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -114,6 +127,8 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerIptorrents ID.",
 				Computed:            true,
@@ -156,11 +171,17 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *IndexerIptorrentsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerIptorrentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerIptorrents
@@ -172,9 +193,15 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create new IndexerIptorrents
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
 
@@ -184,6 +211,7 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -205,9 +233,18 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerIptorrentsResourceName, response.GetImplementation(), response.GetConfigContract(), indexerIptorrentsImplementation, indexerIptorrentsConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -222,7 +259,13 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update IndexerIptorrents
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -234,6 +277,7 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -259,10 +303,16 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerIptorrentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerIptorrentsResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerIptorrentsImplementation))
 	tflog.Trace(ctx, "imported "+indexerIptorrentsResourceName+": "+req.ID)
 }
 
+func (r *IndexerIptorrentsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerIptorrentsResourceName, indexerIptorrentsImplementation, indexerIptorrentsConfigContract, &IndexerIptorrents{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerIptorrents) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
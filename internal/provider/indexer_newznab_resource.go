@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerNewznabResource{}
 	_ resource.ResourceWithImportState = &IndexerNewznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerNewznabResource{}
+	_ resource.ResourceWithMoveState   = &IndexerNewznabResource{}
 )
 
 func NewIndexerNewznabResource() resource.Resource {
@@ -35,12 +37,16 @@ func NewIndexerNewznabResource() resource.Resource {
 
 // IndexerNewznabResource defines the Newznab indexer implementation.
 type IndexerNewznabResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerNewznab describes the Newznab indexer data model.
 type IndexerNewznab struct {
 	Tags                    types.Set    `tfsdk:"tags"`
+	TagLabels               types.Set    `tfsdk:"tag_labels"`
+	TagsAll                 types.Set    `tfsdk:"tags_all"`
 	Categories              types.Set    `tfsdk:"categories"`
 	AdditionalParameters    types.String `tfsdk:"additional_parameters"`
 	BaseURL                 types.String `tfsdk:"base_url"`
@@ -53,6 +59,7 @@ type IndexerNewznab struct {
 	EnableRss               types.Bool   `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool   `tfsdk:"enable_interactive_search"`
 	EnableAutomaticSearch   types.Bool   `tfsdk:"enable_automatic_search"`
+	AdoptExisting           types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerNewznab) toIndexer() *Indexer {
@@ -70,6 +77,7 @@ func (i IndexerNewznab) toIndexer() *Indexer {
 		BaseURL:                 i.BaseURL,
 		Categories:              i.Categories,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Implementation:          types.StringValue(indexerNewznabImplementation),
 		ConfigContract:          types.StringValue(indexerNewznabConfigContract),
 		Protocol:                types.StringValue(indexerNewznabProtocol),
@@ -90,6 +98,7 @@ func (i *IndexerNewznab) fromIndexer(indexer *Indexer) {
 	i.BaseURL = indexer.BaseURL
 	i.Categories = indexer.Categories
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 }
 
 func (r *IndexerNewznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -100,6 +109,10 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Newznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [Newznab](https://wiki.servarr.com/readarr/supported#newznab).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -130,6 +143,8 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerNewznab ID.",
 				Computed:            true,
@@ -174,11 +189,17 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerNewznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerNewznab
@@ -190,9 +211,15 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Create new IndexerNewznab
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
 
@@ -202,6 +229,7 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -223,9 +251,18 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerNewznabResourceName, response.GetImplementation(), response.GetConfigContract(), indexerNewznabImplementation, indexerNewznabConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -240,7 +277,13 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update IndexerNewznab
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -252,6 +295,7 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -277,10 +321,16 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerNewznabResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerNewznabImplementation))
 	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
 }
 
+func (r *IndexerNewznabResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerNewznabResourceName, indexerNewznabImplementation, indexerNewznabConfigContract, &IndexerNewznab{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerNewznab) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerNyaaResource{}
 	_ resource.ResourceWithImportState = &IndexerNyaaResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerNyaaResource{}
+	_ resource.ResourceWithMoveState   = &IndexerNyaaResource{}
 )
 
 func NewIndexerNyaaResource() resource.Resource {
@@ -35,13 +37,17 @@ func NewIndexerNyaaResource() resource.Resource {
 
 // IndexerNyaaResource defines the Nyaa indexer implementation.
 type IndexerNyaaResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerNyaa describes the Nyaa indexer data model.
 type IndexerNyaa struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
+	TagsAll                 types.Set     `tfsdk:"tags_all"`
 	Name                    types.String  `tfsdk:"name"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
 	AdditionalParameters    types.String  `tfsdk:"additional_parameters"`
@@ -54,6 +60,7 @@ type IndexerNyaa struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	AdoptExisting           types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerNyaa) toIndexer() *Indexer {
@@ -72,6 +79,7 @@ func (i IndexerNyaa) toIndexer() *Indexer {
 		SeedRatio:               i.SeedRatio,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Implementation:          types.StringValue(indexerNyaaImplementation),
 		ConfigContract:          types.StringValue(indexerNyaaConfigContract),
 		Protocol:                types.StringValue(indexerNyaaProtocol),
@@ -93,6 +101,7 @@ func (i *IndexerNyaa) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 }
 
 func (r *IndexerNyaaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -103,6 +112,10 @@ func (r *IndexerNyaaResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Nyaa resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [Nyaa](https://wiki.servarr.com/readarr/supported#nyaa).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -133,6 +146,8 @@ func (r *IndexerNyaaResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerNyaa ID.",
 				Computed:            true,
@@ -180,11 +195,17 @@ func (r *IndexerNyaaResource) Schema(_ context.Context, _ resource.SchemaRequest
 }
 
 func (r *IndexerNyaaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerNyaaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerNyaa
@@ -196,9 +217,15 @@ func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Create new IndexerNyaa
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNyaaResourceName, err))
 
@@ -208,6 +235,7 @@ func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Trace(ctx, "created "+indexerNyaaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -229,9 +257,18 @@ func (r *IndexerNyaaResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerNyaaResourceName, response.GetImplementation(), response.GetConfigContract(), indexerNyaaImplementation, indexerNyaaConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerNyaaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -246,7 +283,13 @@ func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Update IndexerNyaa
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -258,6 +301,7 @@ func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Trace(ctx, "updated "+indexerNyaaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -283,10 +327,16 @@ func (r *IndexerNyaaResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *IndexerNyaaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerNyaaResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerNyaaImplementation))
 	tflog.Trace(ctx, "imported "+indexerNyaaResourceName+": "+req.ID)
 }
 
+func (r *IndexerNyaaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerNyaaResourceName, indexerNyaaImplementation, indexerNyaaConfigContract, &IndexerNyaa{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerNyaa) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
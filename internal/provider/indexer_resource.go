@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"strconv"
 
 	"github.com/devopsarr/readarr-go/readarr"
@@ -25,6 +26,7 @@ const indexerResourceName = "indexer"
 var (
 	_ resource.Resource                = &IndexerResource{}
 	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerResource{}
 )
 
 var indexerFields = helpers.Fields{
@@ -43,13 +45,16 @@ func NewIndexerResource() resource.Resource {
 
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // Indexer describes the indexer data model.
 type Indexer struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	Categories              types.Set     `tfsdk:"categories"`
 	Protocol                types.String  `tfsdk:"protocol"`
 	APIPath                 types.String  `tfsdk:"api_path"`
@@ -79,10 +84,47 @@ type Indexer struct {
 	RankedOnly              types.Bool    `tfsdk:"ranked_only"`
 }
 
+// ManagedIndexer describes the indexer resource data model, the Indexer fields plus the resource options.
+type ManagedIndexer struct {
+	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
+	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
+	TagsAll                 types.Set     `tfsdk:"tags_all"`
+	Categories              types.Set     `tfsdk:"categories"`
+	Protocol                types.String  `tfsdk:"protocol"`
+	APIPath                 types.String  `tfsdk:"api_path"`
+	Implementation          types.String  `tfsdk:"implementation"`
+	CaptchaToken            types.String  `tfsdk:"captcha_token"`
+	AdditionalParameters    types.String  `tfsdk:"additional_parameters"`
+	ConfigContract          types.String  `tfsdk:"config_contract"`
+	APIKey                  types.String  `tfsdk:"api_key"`
+	APIUser                 types.String  `tfsdk:"api_user"`
+	Cookie                  types.String  `tfsdk:"cookie"`
+	BaseURL                 types.String  `tfsdk:"base_url"`
+	Username                types.String  `tfsdk:"username"`
+	Password                types.String  `tfsdk:"password"`
+	Passkey                 types.String  `tfsdk:"passkey"`
+	Name                    types.String  `tfsdk:"name"`
+	EarlyReleaseLimit       types.Int64   `tfsdk:"early_release_limit"`
+	Delay                   types.Int64   `tfsdk:"delay"`
+	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
+	ID                      types.Int64   `tfsdk:"id"`
+	SeedTime                types.Int64   `tfsdk:"seed_time"`
+	Priority                types.Int64   `tfsdk:"priority"`
+	DiscographySeedTime     types.Int64   `tfsdk:"author_seed_time"`
+	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	EnableRss               types.Bool    `tfsdk:"enable_rss"`
+	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
+	AllowZeroSize           types.Bool    `tfsdk:"allow_zero_size"`
+	RankedOnly              types.Bool    `tfsdk:"ranked_only"`
+	AdoptExisting           types.Bool    `tfsdk:"adopt_existing"`
+}
+
 func (i Indexer) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                      types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                types.SetType{}.WithElementType(types.StringType),
 			"categories":                types.SetType{}.WithElementType(types.Int64Type),
 			"api_user":                  types.StringType,
 			"additional_parameters":     types.StringType,
@@ -122,6 +164,10 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Generic Indexer resource. When possible use a specific resource instead.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -167,6 +213,8 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -278,14 +326,20 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 }
 
 func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var indexer *Indexer
+	var indexer *ManagedIndexer
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -294,9 +348,15 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create new Indexer
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
 
@@ -306,15 +366,17 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	tflog.Trace(ctx, "created "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state ManagedIndexer
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = indexer.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var indexer *Indexer
+	var indexer *ManagedIndexer
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
 
@@ -333,15 +395,18 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Trace(ctx, "read "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state ManagedIndexer
 
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = indexer.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var indexer *Indexer
+	var indexer *ManagedIndexer
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -350,7 +415,13 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update Indexer
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -362,9 +433,11 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	tflog.Trace(ctx, "updated "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state ManagedIndexer
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = indexer.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -390,7 +463,7 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerResourceName, path.Root("id"), req, resp, indexerLookup(r.client, ""))
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
@@ -429,3 +502,122 @@ func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *readarr.In
 
 	return indexer
 }
+
+// indexerLookup returns an import lookup by name for indexers, restricted to the given implementation if not empty.
+func indexerLookup(client *readarr.APIClient, implementation string) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.IndexerApi.ListIndexer(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name && (implementation == "" || item.GetImplementation() == implementation) {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
+
+func (i ManagedIndexer) toIndexer() *Indexer {
+	return &Indexer{
+		SeedRatio:               i.SeedRatio,
+		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
+		Categories:              i.Categories,
+		Protocol:                i.Protocol,
+		APIPath:                 i.APIPath,
+		Implementation:          i.Implementation,
+		CaptchaToken:            i.CaptchaToken,
+		AdditionalParameters:    i.AdditionalParameters,
+		ConfigContract:          i.ConfigContract,
+		APIKey:                  i.APIKey,
+		APIUser:                 i.APIUser,
+		Cookie:                  i.Cookie,
+		BaseURL:                 i.BaseURL,
+		Username:                i.Username,
+		Password:                i.Password,
+		Passkey:                 i.Passkey,
+		Name:                    i.Name,
+		EarlyReleaseLimit:       i.EarlyReleaseLimit,
+		Delay:                   i.Delay,
+		MinimumSeeders:          i.MinimumSeeders,
+		ID:                      i.ID,
+		SeedTime:                i.SeedTime,
+		Priority:                i.Priority,
+		DiscographySeedTime:     i.DiscographySeedTime,
+		EnableInteractiveSearch: i.EnableInteractiveSearch,
+		EnableRss:               i.EnableRss,
+		EnableAutomaticSearch:   i.EnableAutomaticSearch,
+		AllowZeroSize:           i.AllowZeroSize,
+		RankedOnly:              i.RankedOnly,
+	}
+}
+
+func (i *ManagedIndexer) fromIndexer(indexer *Indexer) {
+	i.SeedRatio = indexer.SeedRatio
+	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
+	i.Categories = indexer.Categories
+	i.Protocol = indexer.Protocol
+	i.APIPath = indexer.APIPath
+	i.Implementation = indexer.Implementation
+	i.CaptchaToken = indexer.CaptchaToken
+	i.AdditionalParameters = indexer.AdditionalParameters
+	i.ConfigContract = indexer.ConfigContract
+	i.APIKey = indexer.APIKey
+	i.APIUser = indexer.APIUser
+	i.Cookie = indexer.Cookie
+	i.BaseURL = indexer.BaseURL
+	i.Username = indexer.Username
+	i.Password = indexer.Password
+	i.Passkey = indexer.Passkey
+	i.Name = indexer.Name
+	i.EarlyReleaseLimit = indexer.EarlyReleaseLimit
+	i.Delay = indexer.Delay
+	i.MinimumSeeders = indexer.MinimumSeeders
+	i.ID = indexer.ID
+	i.SeedTime = indexer.SeedTime
+	i.Priority = indexer.Priority
+	i.DiscographySeedTime = indexer.DiscographySeedTime
+	i.EnableInteractiveSearch = indexer.EnableInteractiveSearch
+	i.EnableRss = indexer.EnableRss
+	i.EnableAutomaticSearch = indexer.EnableAutomaticSearch
+	i.AllowZeroSize = indexer.AllowZeroSize
+	i.RankedOnly = indexer.RankedOnly
+}
+
+func (i *ManagedIndexer) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer()
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(genericIndexer)
+}
+
+func (i *ManagedIndexer) read(ctx context.Context, diags *diag.Diagnostics) *readarr.IndexerResource {
+	return i.toIndexer().read(ctx, diags)
+}
+
+// createIndexer creates an indexer, or updates the existing one with the same name and implementation if adopt is set.
+func createIndexer(ctx context.Context, client *readarr.APIClient, request *readarr.IndexerResource, adopt bool) (*readarr.IndexerResource, error) {
+	return helpers.CreateOrAdopt(ctx, adopt, request.GetName(), indexerLookup(client, request.GetImplementation()),
+		func() (*readarr.IndexerResource, *http.Response, error) {
+			return client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+		},
+		func(id int32) (*readarr.IndexerResource, *http.Response, error) {
+			request.SetId(id)
+
+			return client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(id))).IndexerResource(*request).Execute()
+		},
+	)
+}
+
+// indexerStateMover returns the state mover from the generic indexer resource to a typed one.
+func indexerStateMover(ctx context.Context, resourceName, implementation, configContract string, target interface{ fromIndexer(*Indexer) }) resource.StateMover {
+	return helpers.GenericStateMover(ctx, &IndexerResource{}, indexerResourceName, resourceName, implementation, configContract, func(indexer *ManagedIndexer) interface{} {
+		target.fromIndexer(indexer.toIndexer())
+
+		return target
+	})
+}
@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorrentRssResource{}
 	_ resource.ResourceWithImportState = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithMoveState   = &IndexerTorrentRssResource{}
 )
 
 func NewIndexerTorrentRssResource() resource.Resource {
@@ -35,13 +37,17 @@ func NewIndexerTorrentRssResource() resource.Resource {
 
 // IndexerTorrentRssResource defines the TorrentRss indexer implementation.
 type IndexerTorrentRssResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerTorrentRss describes the TorrentRss indexer data model.
 type IndexerTorrentRss struct {
 	SeedRatio           types.Float64 `tfsdk:"seed_ratio"`
 	Tags                types.Set     `tfsdk:"tags"`
+	TagLabels           types.Set     `tfsdk:"tag_labels"`
+	TagsAll             types.Set     `tfsdk:"tags_all"`
 	Name                types.String  `tfsdk:"name"`
 	BaseURL             types.String  `tfsdk:"base_url"`
 	Cookie              types.String  `tfsdk:"cookie"`
@@ -53,6 +59,7 @@ type IndexerTorrentRss struct {
 	Priority            types.Int64   `tfsdk:"priority"`
 	AllowZeroSize       types.Bool    `tfsdk:"allow_zero_size"`
 	EnableRss           types.Bool    `tfsdk:"enable_rss"`
+	AdoptExisting       types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerTorrentRss) toIndexer() *Indexer {
@@ -70,6 +77,7 @@ func (i IndexerTorrentRss) toIndexer() *Indexer {
 		SeedRatio:           i.SeedRatio,
 		BaseURL:             i.BaseURL,
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Implementation:      types.StringValue(indexerTorrentRssImplementation),
 		ConfigContract:      types.StringValue(indexerTorrentRssConfigContract),
 		Protocol:            types.StringValue(indexerTorrentRssProtocol),
@@ -90,6 +98,7 @@ func (i *IndexerTorrentRss) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 }
 
 func (r *IndexerTorrentRssResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -100,6 +109,10 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Torrent RSS resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [Torrent RSS](https://wiki.servarr.com/readarr/supported#torrentrssindexer).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -120,6 +133,8 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorrentRss ID.",
 				Computed:            true,
@@ -172,11 +187,17 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *IndexerTorrentRssResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerTorrentRssResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorrentRss
@@ -188,9 +209,15 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create new IndexerTorrentRss
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentRssResourceName, err))
 
@@ -200,6 +227,7 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -221,9 +249,18 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerTorrentRssResourceName, response.GetImplementation(), response.GetConfigContract(), indexerTorrentRssImplementation, indexerTorrentRssConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -238,7 +275,13 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update IndexerTorrentRss
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -250,6 +293,7 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -275,10 +319,16 @@ func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerTorrentRssResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerTorrentRssResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerTorrentRssImplementation))
 	tflog.Trace(ctx, "imported "+indexerTorrentRssResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorrentRssResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerTorrentRssResourceName, indexerTorrentRssImplementation, indexerTorrentRssConfigContract, &IndexerTorrentRss{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerTorrentRss) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorrentleechResource{}
 	_ resource.ResourceWithImportState = &IndexerTorrentleechResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorrentleechResource{}
+	_ resource.ResourceWithMoveState   = &IndexerTorrentleechResource{}
 )
 
 func NewIndexerTorrentleechResource() resource.Resource {
@@ -35,13 +37,17 @@ func NewIndexerTorrentleechResource() resource.Resource {
 
 // IndexerTorrentleechResource defines the Torrentleech indexer implementation.
 type IndexerTorrentleechResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerTorrentleech describes the Torrentleech indexer data model.
 type IndexerTorrentleech struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
+	TagsAll                 types.Set     `tfsdk:"tags_all"`
 	Name                    types.String  `tfsdk:"name"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
 	APIKey                  types.String  `tfsdk:"api_key"`
@@ -54,6 +60,7 @@ type IndexerTorrentleech struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	AdoptExisting           types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerTorrentleech) toIndexer() *Indexer {
@@ -72,6 +79,7 @@ func (i IndexerTorrentleech) toIndexer() *Indexer {
 		APIKey:                  i.APIKey,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Implementation:          types.StringValue(indexerTorrentleechImplementation),
 		ConfigContract:          types.StringValue(indexerTorrentleechConfigContract),
 		Protocol:                types.StringValue(indexerTorrentleechProtocol),
@@ -93,6 +101,7 @@ func (i *IndexerTorrentleech) fromIndexer(indexer *Indexer) {
 	i.APIKey = indexer.APIKey
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 }
 
 func (r *IndexerTorrentleechResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -103,6 +112,10 @@ func (r *IndexerTorrentleechResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Torrentleech resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [Torrentleech](https://wiki.servarr.com/readarr/supported#torrentleech).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -133,6 +146,8 @@ func (r *IndexerTorrentleechResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorrentleech ID.",
 				Computed:            true,
@@ -181,11 +196,17 @@ func (r *IndexerTorrentleechResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *IndexerTorrentleechResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerTorrentleechResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerTorrentleechResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorrentleech
@@ -197,9 +218,15 @@ func (r *IndexerTorrentleechResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create new IndexerTorrentleech
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentleechResourceName, err))
 
@@ -209,6 +236,7 @@ func (r *IndexerTorrentleechResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+indexerTorrentleechResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -230,9 +258,18 @@ func (r *IndexerTorrentleechResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerTorrentleechResourceName, response.GetImplementation(), response.GetConfigContract(), indexerTorrentleechImplementation, indexerTorrentleechConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerTorrentleechResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -247,7 +284,13 @@ func (r *IndexerTorrentleechResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Update IndexerTorrentleech
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -259,6 +302,7 @@ func (r *IndexerTorrentleechResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+indexerTorrentleechResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -284,10 +328,16 @@ func (r *IndexerTorrentleechResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *IndexerTorrentleechResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerTorrentleechResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerTorrentleechImplementation))
 	tflog.Trace(ctx, "imported "+indexerTorrentleechResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorrentleechResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerTorrentleechResourceName, indexerTorrentleechImplementation, indexerTorrentleechConfigContract, &IndexerTorrentleech{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerTorrentleech) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
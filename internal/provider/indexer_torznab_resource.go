@@ -27,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorznabResource{}
 	_ resource.ResourceWithImportState = &IndexerTorznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorznabResource{}
+	_ resource.ResourceWithMoveState   = &IndexerTorznabResource{}
 )
 
 func NewIndexerTorznabResource() resource.Resource {
@@ -35,13 +37,17 @@ func NewIndexerTorznabResource() resource.Resource {
 
 // IndexerTorznabResource defines the Torznab indexer implementation.
 type IndexerTorznabResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // IndexerTorznab describes the Torznab indexer data model.
 type IndexerTorznab struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
+	TagsAll                 types.Set     `tfsdk:"tags_all"`
 	Categories              types.Set     `tfsdk:"categories"`
 	Name                    types.String  `tfsdk:"name"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
@@ -57,6 +63,7 @@ type IndexerTorznab struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	AdoptExisting           types.Bool    `tfsdk:"adopt_existing"`
 }
 
 func (i IndexerTorznab) toIndexer() *Indexer {
@@ -77,6 +84,7 @@ func (i IndexerTorznab) toIndexer() *Indexer {
 		APIPath:                 i.APIPath,
 		APIKey:                  i.APIKey,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Categories:              i.Categories,
 		Implementation:          types.StringValue(indexerTorznabImplementation),
 		ConfigContract:          types.StringValue(indexerTorznabConfigContract),
@@ -101,6 +109,7 @@ func (i *IndexerTorznab) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.Categories = indexer.Categories
 }
 
@@ -112,6 +121,10 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Torznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/readarr/settings#indexers) and [Torznab](https://wiki.servarr.com/readarr/supported#torznab).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing indexer with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -142,6 +155,8 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorznab ID.",
 				Computed:            true,
@@ -206,11 +221,17 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *IndexerTorznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *IndexerTorznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorznab
@@ -222,9 +243,15 @@ func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Create new IndexerTorznab
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	response, err := createIndexer(ctx, r.client, request, indexer.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorznabResourceName, err))
 
@@ -234,6 +261,7 @@ func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -255,9 +283,18 @@ func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(indexerTorznabResourceName, response.GetImplementation(), response.GetConfigContract(), indexerTorznabImplementation, indexerTorznabConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -272,7 +309,13 @@ func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update IndexerTorznab
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, indexer.Tags)
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, indexer.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
@@ -284,6 +327,7 @@ func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &indexer.Tags, &indexer.TagLabels, &indexer.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -309,10 +353,16 @@ func (r *IndexerTorznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerTorznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, indexerTorznabResourceName, path.Root("id"), req, resp, indexerLookup(r.client, indexerTorznabImplementation))
 	tflog.Trace(ctx, "imported "+indexerTorznabResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorznabResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		indexerStateMover(ctx, indexerTorznabResourceName, indexerTorznabImplementation, indexerTorznabConfigContract, &IndexerTorznab{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (i *IndexerTorznab) write(ctx context.Context, indexer *readarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -87,6 +87,11 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Indexer ID.",
 							Computed:            true,
@@ -193,11 +198,20 @@ func (d *IndexersDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+indexersDataSourceName)
 	// Map response body to resource schema attribute
 	indexers := make([]Indexer, len(response))
 	for i, p := range response {
 		indexers[i].write(ctx, p, &resp.Diagnostics)
+		indexers[i].TagLabels = helpers.TagLabels(ctx, tagList, indexers[i].Tags, &resp.Diagnostics)
 	}
 
 	indexerList, diags := types.SetValueFrom(ctx, Indexer{}.getType(), indexers)
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	logsDataSourceName = "logs"
+	logsDefaultMax     = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LogsDataSource{}
+
+func NewLogsDataSource() datasource.DataSource {
+	return &LogsDataSource{}
+}
+
+// LogsDataSource defines the logs implementation.
+type LogsDataSource struct {
+	client *readarr.APIClient
+}
+
+// Logs describes the logs data model.
+type Logs struct {
+	Logs       types.List   `tfsdk:"logs"`
+	ID         types.String `tfsdk:"id"`
+	Level      types.String `tfsdk:"level"`
+	Since      types.String `tfsdk:"since"`
+	MaxEntries types.Int64  `tfsdk:"max_entries"`
+}
+
+// Log is part of Logs.
+type Log struct {
+	Time      types.String `tfsdk:"time"`
+	Level     types.String `tfsdk:"level"`
+	Logger    types.String `tfsdk:"logger"`
+	Message   types.String `tfsdk:"message"`
+	Exception types.String `tfsdk:"exception"`
+	ID        types.Int64  `tfsdk:"id"`
+}
+
+func (l Log) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"time":      types.StringType,
+			"level":     types.StringType,
+			"logger":    types.StringType,
+			"message":   types.StringType,
+			"exception": types.StringType,
+			"id":        types.Int64Type,
+		})
+}
+
+func (d *LogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + logsDataSourceName
+}
+
+func (d *LogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->List the most recent application log entries, newest first.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"level": schema.StringAttribute{
+				MarkdownDescription: "Minimum level. Valid values are: `trace`, `debug`, `info`, `warn`, `error`, `fatal`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("trace", "debug", "info", "warn", "error", "fatal"),
+				},
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return entries after this RFC3339 timestamp.",
+				Optional:            true,
+			},
+			"max_entries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of entries. Defaults to `100`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"logs": schema.ListNestedAttribute{
+				MarkdownDescription: "Log entry list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Log ID.",
+							Computed:            true,
+						},
+						"time": schema.StringAttribute{
+							MarkdownDescription: "Log time, as RFC3339 timestamp.",
+							Computed:            true,
+						},
+						"level": schema.StringAttribute{
+							MarkdownDescription: "Log level.",
+							Computed:            true,
+						},
+						"logger": schema.StringAttribute{
+							MarkdownDescription: "Logger name.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Log message.",
+							Computed:            true,
+						},
+						"exception": schema.StringAttribute{
+							MarkdownDescription: "Exception.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *LogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var logs *Logs
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &logs)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := time.Time{}
+
+	if !logs.Since.IsNull() {
+		var err error
+
+		since, err = time.Parse(time.RFC3339, logs.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("since"), helpers.DataSourceError, err.Error())
+
+			return
+		}
+	}
+
+	maxEntries := logsDefaultMax
+	if !logs.MaxEntries.IsNull() {
+		maxEntries = int(logs.MaxEntries.ValueInt64())
+	}
+
+	// Get logs current value
+	response, err := helpers.ListLogs(ctx, d.client, logs.Level.ValueString(), since, maxEntries, helpers.LogPageSize)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, logsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+logsDataSourceName)
+	// Map response body to resource schema attribute
+	entries := make([]Log, len(response))
+	for i, l := range response {
+		entries[i].write(l)
+	}
+
+	logList, diags := types.ListValueFrom(ctx, Log{}.getType(), entries)
+	resp.Diagnostics.Append(diags...)
+
+	logs.Logs = logList
+	logs.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &logs)...)
+}
+
+func (l *Log) write(log *readarr.LogResource) {
+	l.ID = types.Int64Value(int64(log.GetId()))
+	l.Time = types.StringValue(log.GetTime().Format(time.RFC3339))
+	l.Level = types.StringValue(log.GetLevel())
+	l.Logger = types.StringValue(log.GetLogger())
+	l.Message = types.StringValue(log.GetMessage())
+	l.Exception = types.StringValue(log.GetException())
+}
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	manualImportResourceName = "manual_import"
+	manualImportCommand      = "ManualImport"
+	manualImportNoFilesError = "No Files To Import"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &ManualImportResource{}
+	_ resource.ResourceWithModifyPlan = &ManualImportResource{}
+)
+
+func NewManualImportResource() resource.Resource {
+	return &ManualImportResource{}
+}
+
+// ManualImportResource defines the manual import implementation.
+type ManualImportResource struct {
+	client *readarr.APIClient
+}
+
+// ManualImport describes the manual import data model.
+type ManualImport struct {
+	Files                types.Set    `tfsdk:"files"`
+	Folder               types.String `tfsdk:"folder"`
+	ImportMode           types.String `tfsdk:"import_mode"`
+	ID                   types.Int64  `tfsdk:"id"`
+	AuthorID             types.Int64  `tfsdk:"author_id"`
+	BookID               types.Int64  `tfsdk:"book_id"`
+	ReplaceExistingFiles types.Bool   `tfsdk:"replace_existing_files"`
+}
+
+func (r *ManualImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + manualImportResourceName
+}
+
+func (r *ManualImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->Manual Import resource.\nIt imports the files of a folder that Readarr cannot match automatically and waits for completion. The plan fails if the folder has no files to import or any file is rejected, destroying it only removes it from the state.\nFor more information refer to [Manual Import](https://wiki.servarr.com/readarr/activity#manual-import) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Manual import command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "Folder to import.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID hint, required if files cannot be matched to an author.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID hint, its monitored edition is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"import_mode": schema.StringAttribute{
+				MarkdownDescription: "Import mode. Valid values are: `move`, `copy`. Defaults to `move`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("move"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("move", "copy"),
+				},
+			},
+			"replace_existing_files": schema.BoolAttribute{
+				MarkdownDescription: "Replace existing files flag. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.SetAttribute{
+				MarkdownDescription: "Imported file paths.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ManualImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ManualImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, on unchanged resources or with an unconfigured provider
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	var importItem *ManualImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importItem)...)
+
+	if resp.Diagnostics.HasError() || importItem.Folder.IsUnknown() || importItem.AuthorID.IsUnknown() || importItem.BookID.IsUnknown() {
+		return
+	}
+
+	// Fail plan on empty folder or rejected files
+	items, rejections, err := r.evaluate(ctx, importItem)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, manualImportResourceName, err))
+
+		return
+	}
+
+	importItem.check(items, rejections, &resp.Diagnostics)
+}
+
+func (r *ManualImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var importItem *ManualImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importItem)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Evaluate files again, folder content may have changed since plan
+	items, rejections, err := r.evaluate(ctx, importItem)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, manualImportResourceName, err))
+
+		return
+	}
+
+	if importItem.check(items, rejections, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run manual import command and wait for completion
+	files := make([]map[string]interface{}, len(items))
+	paths := make([]string, len(items))
+
+	for i, item := range items {
+		files[i] = importItem.file(item)
+		paths[i] = item.GetPath()
+	}
+
+	response, err := helpers.ExecuteCommand(ctx, r.client, manualImportCommand, map[string]interface{}{
+		"files":                files,
+		"importMode":           importItem.ImportMode.ValueString(),
+		"replaceExistingFiles": importItem.ReplaceExistingFiles.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, manualImportResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+manualImportResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	importItem.ID = types.Int64Value(int64(response.GetId()))
+
+	var diags diag.Diagnostics
+
+	importItem.Files, diags = types.SetValueFrom(ctx, types.StringType, paths)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importItem)...)
+}
+
+func (r *ManualImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Manual import is a one time action, nothing to refresh
+	var importItem *ManualImport
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &importItem)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+manualImportResourceName+": "+strconv.Itoa(int(importItem.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importItem)...)
+}
+
+func (r *ManualImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var importItem *ManualImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importItem)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Manual import cannot be updated, every change requires a replacement
+	tflog.Trace(ctx, "updated "+manualImportResourceName+": "+strconv.Itoa(int(importItem.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importItem)...)
+}
+
+func (r *ManualImportResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Manual import cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+manualImportResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// evaluate lists the importable files of the folder applying the hints, together with their rejections.
+func (r *ManualImportResource) evaluate(ctx context.Context, importItem *ManualImport) ([]*readarr.ManualImportResource, []string, error) {
+	request := r.client.ManualImportApi.ListManualImport(ctx).
+		Folder(importItem.Folder.ValueString()).
+		FilterExistingFiles(!importItem.ReplaceExistingFiles.ValueBool()).
+		ReplaceExistingFiles(importItem.ReplaceExistingFiles.ValueBool())
+	if !importItem.AuthorID.IsNull() {
+		request = request.AuthorId(int32(importItem.AuthorID.ValueInt64()))
+	}
+
+	items, _, err := request.Execute()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(items) == 0 {
+		return items, nil, nil
+	}
+
+	// Book hint is applied by re-evaluating the items against its monitored edition
+	if !importItem.BookID.IsNull() {
+		items, err = r.evaluateBook(ctx, importItem, items)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var rejections []string
+
+	for _, item := range items {
+		for _, rejection := range item.GetRejections() {
+			rejections = append(rejections, item.GetPath()+": "+rejection.GetReason())
+		}
+	}
+
+	return items, rejections, nil
+}
+
+// evaluateBook re-evaluates the items against the monitored edition of the hinted book.
+func (r *ManualImportResource) evaluateBook(ctx context.Context, importItem *ManualImport, items []*readarr.ManualImportResource) ([]*readarr.ManualImportResource, error) {
+	book, _, err := r.client.BookApi.GetBookById(ctx, int32(importItem.BookID.ValueInt64())).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	edition := ""
+
+	for _, e := range book.GetEditions() {
+		if e.GetMonitored() {
+			edition = e.GetForeignEditionId()
+		}
+	}
+
+	updates := make([]readarr.ManualImportUpdateResource, len(items))
+	for i, item := range items {
+		updates[i] = *readarr.NewManualImportUpdateResource()
+		updates[i].SetId(item.GetId())
+		updates[i].SetPath(item.GetPath())
+		updates[i].SetName(item.GetName())
+		updates[i].SetAuthorId(book.GetAuthorId())
+		updates[i].SetBookId(book.GetId())
+		updates[i].SetForeignEditionId(edition)
+		updates[i].SetQuality(item.GetQuality())
+		updates[i].SetReleaseGroup(item.GetReleaseGroup())
+		updates[i].SetDownloadId(item.GetDownloadId())
+		updates[i].SetAdditionalFile(item.GetAdditionalFile())
+		updates[i].SetReplaceExistingFiles(importItem.ReplaceExistingFiles.ValueBool())
+		updates[i].SetDisableReleaseSwitching(item.GetDisableReleaseSwitching())
+	}
+
+	httpResp, err := r.client.ManualImportApi.CreateManualImport(ctx).ManualImportUpdateResource(updates).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	defer httpResp.Body.Close()
+
+	// The SDK does not decode the re-evaluated items
+	var response []*readarr.ManualImportResource
+	if err := json.NewDecoder(httpResp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// check reports an empty folder or the rejected files.
+func (m *ManualImport) check(items []*readarr.ManualImportResource, rejections []string, diags *diag.Diagnostics) {
+	if len(items) == 0 {
+		diags.AddError(manualImportNoFilesError, "Manual import of "+m.Folder.ValueString()+" found no files to import.")
+
+		return
+	}
+
+	if len(rejections) != 0 {
+		diags.AddError(helpers.ResourceError, "Manual import of "+m.Folder.ValueString()+" has rejections:\n"+strings.Join(rejections, "\n"))
+	}
+}
+
+// file builds the manual import command file, since the SDK has no model for it.
+func (m *ManualImport) file(item *readarr.ManualImportResource) map[string]interface{} {
+	file := map[string]interface{}{
+		"path":                    item.GetPath(),
+		"foreignEditionId":        item.GetForeignEditionId(),
+		"quality":                 item.GetQuality(),
+		"releaseGroup":            item.GetReleaseGroup(),
+		"downloadId":              item.GetDownloadId(),
+		"additionalFile":          item.GetAdditionalFile(),
+		"replaceExistingFiles":    m.ReplaceExistingFiles.ValueBool(),
+		"disableReleaseSwitching": item.GetDisableReleaseSwitching(),
+	}
+
+	if item.Author != nil {
+		file["authorId"] = item.Author.GetId()
+	} else if !m.AuthorID.IsNull() {
+		file["authorId"] = m.AuthorID.ValueInt64()
+	}
+
+	if item.Book != nil {
+		file["bookId"] = item.Book.GetId()
+	}
+
+	return file
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccManualImportResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccManualImportResourceConfig("/tmp") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Empty folder plan
+			{
+				Config:      testAccManualImportResourceConfig("/tmp"),
+				ExpectError: regexp.MustCompile("No Files To Import"),
+			},
+		},
+	})
+}
+
+func testAccManualImportResourceConfig(folder string) string {
+	return `
+	resource "readarr_manual_import" "test" {
+		folder = "` + folder + `"
+		import_mode = "copy"
+	}`
+}
@@ -232,7 +232,7 @@ func (r *MetadataProfileResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *MetadataProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, metadataProfileResourceName, path.Root("id"), req, resp, metadataProfileLookup(r.client))
 	tflog.Trace(ctx, "imported "+metadataProfileResourceName+": "+req.ID)
 }
 
@@ -267,3 +267,21 @@ func (p *MetadataProfile) read(ctx context.Context, diags *diag.Diagnostics) *re
 
 	return profile
 }
+
+// metadataProfileLookup returns an import lookup by name for metadata profiles.
+func metadataProfileLookup(client *readarr.APIClient) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.MetadataProfileApi.ListMetadataProfile(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
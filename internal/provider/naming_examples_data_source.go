@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	namingExamplesDataSourceName = "naming_examples"
+	namingSmartColonReplacement  = 4
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamingExamplesDataSource{}
+
+func NewNamingExamplesDataSource() datasource.DataSource {
+	return &NamingExamplesDataSource{}
+}
+
+// NamingExamplesDataSource defines the naming examples implementation.
+type NamingExamplesDataSource struct {
+	client *readarr.APIClient
+}
+
+// NamingExamples describes the naming examples data model.
+type NamingExamples struct {
+	AuthorFolderFormat       types.String `tfsdk:"author_folder_format"`
+	StandardBookFormat       types.String `tfsdk:"standard_book_format"`
+	SingleBookExample        types.String `tfsdk:"single_book_example"`
+	MultiPartBookExample     types.String `tfsdk:"multi_part_book_example"`
+	AuthorFolderExample      types.String `tfsdk:"author_folder_example"`
+	ID                       types.String `tfsdk:"id"`
+	ColonReplacementFormat   types.Int64  `tfsdk:"colon_replacement_format"`
+	RenameBooks              types.Bool   `tfsdk:"rename_books"`
+	ReplaceIllegalCharacters types.Bool   `tfsdk:"replace_illegal_characters"`
+}
+
+// namingExamplesResponse maps the naming examples response, not modeled by the SDK.
+type namingExamplesResponse struct {
+	SingleBookExample    string `json:"singleBookExample"`
+	MultiPartBookExample string `json:"multiPartBookExample"`
+	AuthorFolderExample  string `json:"authorFolderExample"`
+}
+
+func (d *NamingExamplesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + namingExamplesDataSourceName
+}
+
+func (d *NamingExamplesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->Render examples of a proposed [Naming](../resources/naming) configuration.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"rename_books": schema.BoolAttribute{
+				MarkdownDescription: "Readarr will use the existing file name if false. Defaults to `true`.",
+				Optional:            true,
+			},
+			"replace_illegal_characters": schema.BoolAttribute{
+				MarkdownDescription: "Replace illegal characters. They will be removed if false. Defaults to `true`.",
+				Optional:            true,
+			},
+			"colon_replacement_format": schema.Int64Attribute{
+				MarkdownDescription: "Change how Readarr handles colon replacement. '0' Delete, '1' Dash, '2' Space Dash, '3' Space Dash Space, '4' Smart. Defaults to `4`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2, 3, 4),
+				},
+			},
+			"author_folder_format": schema.StringAttribute{
+				MarkdownDescription: "Author folder format.",
+				Required:            true,
+			},
+			"standard_book_format": schema.StringAttribute{
+				MarkdownDescription: "Standard book format.",
+				Required:            true,
+			},
+			"single_book_example": schema.StringAttribute{
+				MarkdownDescription: "Single book file example.",
+				Computed:            true,
+			},
+			"multi_part_book_example": schema.StringAttribute{
+				MarkdownDescription: "Multi part book file example.",
+				Computed:            true,
+			},
+			"author_folder_example": schema.StringAttribute{
+				MarkdownDescription: "Author folder example.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NamingExamplesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *NamingExamplesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *NamingExamples
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply UI defaults to unset flags
+	if data.RenameBooks.IsNull() {
+		data.RenameBooks = types.BoolValue(true)
+	}
+
+	if data.ReplaceIllegalCharacters.IsNull() {
+		data.ReplaceIllegalCharacters = types.BoolValue(true)
+	}
+
+	if data.ColonReplacementFormat.IsNull() {
+		data.ColonReplacementFormat = types.Int64Value(namingSmartColonReplacement)
+	}
+
+	// Get naming examples current value
+	httpResp, err := d.client.NamingConfigApi.GetNamingConfigExamples(ctx).
+		RenameBooks(data.RenameBooks.ValueBool()).
+		ReplaceIllegalCharacters(data.ReplaceIllegalCharacters.ValueBool()).
+		ColonReplacementFormat(int32(data.ColonReplacementFormat.ValueInt64())).
+		StandardBookFormat(data.StandardBookFormat.ValueString()).
+		AuthorFolderFormat(data.AuthorFolderFormat.ValueString()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingExamplesDataSourceName, err))
+
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	var examples namingExamplesResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&examples); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingExamplesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+namingExamplesDataSourceName)
+	// Map response body to resource schema attribute
+	data.SingleBookExample = types.StringValue(examples.SingleBookExample)
+	data.MultiPartBookExample = types.StringValue(examples.MultiPartBookExample)
+	data.AuthorFolderExample = types.StringValue(examples.AuthorFolderExample)
+	data.ID = types.StringValue(data.StandardBookFormat.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
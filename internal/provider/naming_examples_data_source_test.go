@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamingExamplesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccNamingExamplesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccNamingExamplesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_naming_examples.test", "author_folder_example", "Jane Austen"),
+					resource.TestCheckResourceAttrSet("data.readarr_naming_examples.test", "single_book_example"),
+					resource.TestCheckResourceAttrSet("data.readarr_naming_examples.test", "multi_part_book_example"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNamingExamplesDataSourceConfig = `
+data "readarr_naming_examples" "test" {
+	author_folder_format = "{Author Name}"
+	standard_book_format = "{Book Title}/{Author Name} - {Book Title}{ (PartNumber)}"
+}
+`
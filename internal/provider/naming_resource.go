@@ -75,12 +75,18 @@ func (r *NamingResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"author_folder_format": schema.StringAttribute{
-				MarkdownDescription: "Author folder format.",
+				MarkdownDescription: "Author folder format. Tokens are validated offline.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.NamingFormatValidator(),
+				},
 			},
 			"standard_book_format": schema.StringAttribute{
-				MarkdownDescription: "Standard book formatss.",
+				MarkdownDescription: "Standard book formatss. Tokens are validated offline.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.NamingFormatValidator(),
+				},
 			},
 		},
 	}
@@ -15,6 +15,11 @@ func TestAccNamingResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid naming token
+			{
+				Config:      testAccNamingResourceConfig("{Author Nme}"),
+				ExpectError: regexp.MustCompile("Invalid Naming Format"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccNamingResourceConfig("{Author Name}") + testUnauthorizedProvider,
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationBoxcarResource{}
 	_ resource.ResourceWithImportState = &NotificationBoxcarResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationBoxcarResource{}
+	_ resource.ResourceWithMoveState   = &NotificationBoxcarResource{}
 )
 
 func NewNotificationBoxcarResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationBoxcarResource() resource.Resource {
 
 // NotificationBoxcarResource defines the notification implementation.
 type NotificationBoxcarResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationBoxcar describes the notification data model.
 type NotificationBoxcar struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Token                      types.String `tfsdk:"token"`
 	Name                       types.String `tfsdk:"name"`
 	ID                         types.Int64  `tfsdk:"id"`
@@ -55,11 +61,13 @@ type NotificationBoxcar struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationBoxcar) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		Token:                      n.Token,
 		Name:                       n.Name,
 		ID:                         n.ID,
@@ -82,6 +90,7 @@ func (n NotificationBoxcar) toNotification() *Notification {
 
 func (n *NotificationBoxcar) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Token = notification.Token
 	n.Name = notification.Name
 	n.ID = notification.ID
@@ -107,6 +116,10 @@ func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Boxcar resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Boxcar](https://wiki.servarr.com/readarr/supported#boxcar).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -177,6 +190,8 @@ func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -195,11 +210,17 @@ func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *NotificationBoxcarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationBoxcarResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationBoxcar
@@ -211,9 +232,15 @@ func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Create new NotificationBoxcar
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationBoxcarResourceName, err))
 
@@ -223,6 +250,7 @@ func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created "+notificationBoxcarResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -244,9 +272,18 @@ func (r *NotificationBoxcarResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationBoxcarResourceName, response.GetImplementation(), response.GetConfigContract(), notificationBoxcarImplementation, notificationBoxcarConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationBoxcarResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -261,7 +298,13 @@ func (r *NotificationBoxcarResource) Update(ctx context.Context, req resource.Up
 	}
 
 	// Update NotificationBoxcar
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -273,6 +316,7 @@ func (r *NotificationBoxcarResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated "+notificationBoxcarResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -298,10 +342,16 @@ func (r *NotificationBoxcarResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationBoxcarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationBoxcarResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationBoxcarImplementation))
 	tflog.Trace(ctx, "imported "+notificationBoxcarResourceName+": "+req.ID)
 }
 
+func (r *NotificationBoxcarResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationBoxcarResourceName, notificationBoxcarImplementation, notificationBoxcarConfigContract, &NotificationBoxcar{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationBoxcar) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
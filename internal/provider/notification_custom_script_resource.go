@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationCustomScriptResource{}
 	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithMoveState   = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -34,12 +36,17 @@ func NewNotificationCustomScriptResource() resource.Resource {
 
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	checkPaths        bool
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Arguments                  types.String `tfsdk:"arguments"`
 	Path                       types.String `tfsdk:"path"`
 	Name                       types.String `tfsdk:"name"`
@@ -56,11 +63,13 @@ type NotificationCustomScript struct {
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationCustomScript) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		Path:                       n.Path,
 		Arguments:                  n.Arguments,
 		Name:                       n.Name,
@@ -84,6 +93,7 @@ func (n NotificationCustomScript) toNotification() *Notification {
 
 func (n *NotificationCustomScript) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Path = notification.Path
 	n.Arguments = notification.Arguments
 	n.Name = notification.Name
@@ -110,6 +120,10 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Custom Script resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Custom Script](https://wiki.servarr.com/readarr/supported#customscript).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -180,6 +194,8 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -194,7 +210,7 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 				Computed:            true,
 			},
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Path.",
+				MarkdownDescription: "Path. Checked on the Readarr host if provider `check_paths` is set.",
 				Required:            true,
 			},
 		},
@@ -202,9 +218,20 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 }
 
 func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.checkPaths = data.CheckPaths
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
+	}
+}
+
+func (r *NotificationCustomScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.checkPaths {
+		helpers.CheckPlanPaths(ctx, r.client, req, resp, "path")
 	}
+
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
 }
 
 func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -218,9 +245,15 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 	}
 
 	// Create new NotificationCustomScript
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
 
@@ -230,6 +263,7 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 	tflog.Trace(ctx, "created "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -251,9 +285,18 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationCustomScriptResourceName, response.GetImplementation(), response.GetConfigContract(), notificationCustomScriptImplementation, notificationCustomScriptConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -268,7 +311,13 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 	}
 
 	// Update NotificationCustomScript
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -280,6 +329,7 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 	tflog.Trace(ctx, "updated "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -305,10 +355,16 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationCustomScriptResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationCustomScriptImplementation))
 	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
 }
 
+func (r *NotificationCustomScriptResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationCustomScriptResourceName, notificationCustomScriptImplementation, notificationCustomScriptConfigContract, &NotificationCustomScript{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationCustomScript) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationDiscordResource{}
 	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationDiscordResource{}
+	_ resource.ResourceWithMoveState   = &NotificationDiscordResource{}
 )
 
 func NewNotificationDiscordResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationDiscordResource() resource.Resource {
 
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationDiscord describes the notification data model.
 type NotificationDiscord struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	WebHookURL                 types.String `tfsdk:"web_hook_url"`
 	Name                       types.String `tfsdk:"name"`
 	Username                   types.String `tfsdk:"username"`
@@ -59,11 +65,13 @@ type NotificationDiscord struct {
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
 	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationDiscord) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		WebHookURL:                 n.WebHookURL,
 		Username:                   n.Username,
 		Avatar:                     n.Avatar,
@@ -90,6 +98,7 @@ func (n NotificationDiscord) toNotification() *Notification {
 
 func (n *NotificationDiscord) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.WebHookURL = notification.WebHookURL
 	n.Username = notification.Username
 	n.Avatar = notification.Avatar
@@ -119,6 +128,10 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Discord resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Discord](https://wiki.servarr.com/readarr/supported#discord).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -199,6 +212,8 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -226,11 +241,17 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationDiscordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationDiscordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationDiscord
@@ -242,9 +263,15 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create new NotificationDiscord
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
 
@@ -254,6 +281,7 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -275,9 +303,18 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationDiscordResourceName, response.GetImplementation(), response.GetConfigContract(), notificationDiscordImplementation, notificationDiscordConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -292,7 +329,13 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Update NotificationDiscord
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -304,6 +347,7 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -329,10 +373,16 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationDiscordResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationDiscordImplementation))
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
 
+func (r *NotificationDiscordResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationDiscordResourceName, notificationDiscordImplementation, notificationDiscordConfigContract, &NotificationDiscord{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationDiscord) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmailResource{}
 	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmailResource{}
+	_ resource.ResourceWithMoveState   = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationEmail describes the notification data model.
 type NotificationEmail struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	To                         types.Set    `tfsdk:"to"`
 	Cc                         types.Set    `tfsdk:"cc"`
 	Bcc                        types.Set    `tfsdk:"bcc"`
@@ -64,11 +70,13 @@ type NotificationEmail struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		From:                       n.From,
 		To:                         n.To,
 		Cc:                         n.Cc,
@@ -100,6 +108,7 @@ func (n NotificationEmail) toNotification() *Notification {
 
 func (n *NotificationEmail) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.From = notification.From
 	n.To = notification.To
 	n.Cc = notification.Cc
@@ -134,6 +143,10 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Email resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Email](https://wiki.servarr.com/readarr/supported#email).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -204,6 +217,8 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -268,11 +283,17 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationEmailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmail
@@ -284,9 +305,15 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create new NotificationEmail
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
 
@@ -296,6 +323,7 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -317,9 +345,18 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationEmailResourceName, response.GetImplementation(), response.GetConfigContract(), notificationEmailImplementation, notificationEmailConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -334,7 +371,13 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update NotificationEmail
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -346,6 +389,7 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -371,10 +415,16 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationEmailResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationEmailImplementation))
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
+func (r *NotificationEmailResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationEmailResourceName, notificationEmailImplementation, notificationEmailConfigContract, &NotificationEmail{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationEmail) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
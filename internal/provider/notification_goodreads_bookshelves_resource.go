@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationGoodreadsBookshelvesResource{}
 	_ resource.ResourceWithImportState = &NotificationGoodreadsBookshelvesResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationGoodreadsBookshelvesResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGoodreadsBookshelvesResource{}
 )
 
 func NewNotificationGoodreadsBookshelvesResource() resource.Resource {
@@ -34,7 +36,9 @@ func NewNotificationGoodreadsBookshelvesResource() resource.Resource {
 
 // NotificationGoodreadsBookshelvesResource defines the notification implementation.
 type NotificationGoodreadsBookshelvesResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationGoodreadsBookshelves describes the notification data model.
@@ -42,6 +46,8 @@ type NotificationGoodreadsBookshelves struct {
 	AddIds                     types.Set    `tfsdk:"add_ids"`
 	RemoveIds                  types.Set    `tfsdk:"remove_ids"`
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	AccessToken                types.String `tfsdk:"access_token"`
 	AccessTokenSecret          types.String `tfsdk:"access_token_secret"`
 	RequestTokenSecret         types.String `tfsdk:"request_token_secret"`
@@ -56,11 +62,13 @@ type NotificationGoodreadsBookshelves struct {
 	OnBookDelete               types.Bool   `tfsdk:"on_book_delete"`
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationGoodreadsBookshelves) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		AddIds:                     n.AddIds,
 		RemoveIds:                  n.RemoveIds,
 		AccessToken:                n.AccessToken,
@@ -84,6 +92,7 @@ func (n NotificationGoodreadsBookshelves) toNotification() *Notification {
 
 func (n *NotificationGoodreadsBookshelves) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.AddIds = notification.AddIds
 	n.RemoveIds = notification.RemoveIds
 	n.AccessToken = notification.AccessToken
@@ -110,6 +119,10 @@ func (r *NotificationGoodreadsBookshelvesResource) Schema(_ context.Context, _ r
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification GoodreadsBookshelves resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [GoodreadsBookshelves](https://wiki.servarr.com/readarr/supported#goodreadsbookshelf).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
 				Optional:            true,
@@ -155,6 +168,8 @@ func (r *NotificationGoodreadsBookshelvesResource) Schema(_ context.Context, _ r
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -205,11 +220,17 @@ func (r *NotificationGoodreadsBookshelvesResource) Schema(_ context.Context, _ r
 }
 
 func (r *NotificationGoodreadsBookshelvesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationGoodreadsBookshelvesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationGoodreadsBookshelvesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationGoodreadsBookshelves
@@ -221,9 +242,15 @@ func (r *NotificationGoodreadsBookshelvesResource) Create(ctx context.Context, r
 	}
 
 	// Create new NotificationGoodreadsBookshelves
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationGoodreadsBookshelvesResourceName, err))
 
@@ -233,6 +260,7 @@ func (r *NotificationGoodreadsBookshelvesResource) Create(ctx context.Context, r
 	tflog.Trace(ctx, "created "+notificationGoodreadsBookshelvesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -254,9 +282,18 @@ func (r *NotificationGoodreadsBookshelvesResource) Read(ctx context.Context, req
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationGoodreadsBookshelvesResourceName, response.GetImplementation(), response.GetConfigContract(), notificationGoodreadsBookshelvesImplementation, notificationGoodreadsBookshelvesConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationGoodreadsBookshelvesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -271,7 +308,13 @@ func (r *NotificationGoodreadsBookshelvesResource) Update(ctx context.Context, r
 	}
 
 	// Update NotificationGoodreadsBookshelves
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -283,6 +326,7 @@ func (r *NotificationGoodreadsBookshelvesResource) Update(ctx context.Context, r
 	tflog.Trace(ctx, "updated "+notificationGoodreadsBookshelvesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -308,10 +352,16 @@ func (r *NotificationGoodreadsBookshelvesResource) Delete(ctx context.Context, r
 }
 
 func (r *NotificationGoodreadsBookshelvesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationGoodreadsBookshelvesResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationGoodreadsBookshelvesImplementation))
 	tflog.Trace(ctx, "imported "+notificationGoodreadsBookshelvesResourceName+": "+req.ID)
 }
 
+func (r *NotificationGoodreadsBookshelvesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationGoodreadsBookshelvesResourceName, notificationGoodreadsBookshelvesImplementation, notificationGoodreadsBookshelvesConfigContract, &NotificationGoodreadsBookshelves{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationGoodreadsBookshelves) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -28,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationGoodreadsOwnedBooksResource{}
 	_ resource.ResourceWithImportState = &NotificationGoodreadsOwnedBooksResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationGoodreadsOwnedBooksResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGoodreadsOwnedBooksResource{}
 )
 
 func NewNotificationGoodreadsOwnedBooksResource() resource.Resource {
@@ -36,12 +38,16 @@ func NewNotificationGoodreadsOwnedBooksResource() resource.Resource {
 
 // NotificationGoodreadsOwnedBooksResource defines the notification implementation.
 type NotificationGoodreadsOwnedBooksResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationGoodreadsOwnedBooks describes the notification data model.
 type NotificationGoodreadsOwnedBooks struct {
 	Tags               types.Set    `tfsdk:"tags"`
+	TagLabels          types.Set    `tfsdk:"tag_labels"`
+	TagsAll            types.Set    `tfsdk:"tags_all"`
 	AccessToken        types.String `tfsdk:"access_token"`
 	AccessTokenSecret  types.String `tfsdk:"access_token_secret"`
 	RequestTokenSecret types.String `tfsdk:"request_token_secret"`
@@ -54,11 +60,13 @@ type NotificationGoodreadsOwnedBooks struct {
 	Condition          types.Int64  `tfsdk:"condition"`
 	OnUpgrade          types.Bool   `tfsdk:"on_upgrade"`
 	OnReleaseImport    types.Bool   `tfsdk:"on_release_import"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationGoodreadsOwnedBooks) toNotification() *Notification {
 	return &Notification{
 		Tags:               n.Tags,
+		TagLabels:          n.TagLabels,
 		AccessToken:        n.AccessToken,
 		AccessTokenSecret:  n.AccessTokenSecret,
 		RequestTokenSecret: n.RequestTokenSecret,
@@ -78,6 +86,7 @@ func (n NotificationGoodreadsOwnedBooks) toNotification() *Notification {
 
 func (n *NotificationGoodreadsOwnedBooks) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.AccessToken = notification.AccessToken
 	n.AccessTokenSecret = notification.AccessTokenSecret
 	n.RequestTokenSecret = notification.RequestTokenSecret
@@ -100,6 +109,10 @@ func (r *NotificationGoodreadsOwnedBooksResource) Schema(_ context.Context, _ re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification GoodreadsOwnedBooks resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [GoodreadsOwnedBooks](https://wiki.servarr.com/readarr/supported#goodreadsownedbooks).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
 				Optional:            true,
@@ -120,6 +133,8 @@ func (r *NotificationGoodreadsOwnedBooksResource) Schema(_ context.Context, _ re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -175,11 +190,17 @@ func (r *NotificationGoodreadsOwnedBooksResource) Schema(_ context.Context, _ re
 }
 
 func (r *NotificationGoodreadsOwnedBooksResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationGoodreadsOwnedBooksResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationGoodreadsOwnedBooksResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationGoodreadsOwnedBooks
@@ -191,9 +212,15 @@ func (r *NotificationGoodreadsOwnedBooksResource) Create(ctx context.Context, re
 	}
 
 	// Create new NotificationGoodreadsOwnedBooks
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationGoodreadsOwnedBooksResourceName, err))
 
@@ -203,6 +230,7 @@ func (r *NotificationGoodreadsOwnedBooksResource) Create(ctx context.Context, re
 	tflog.Trace(ctx, "created "+notificationGoodreadsOwnedBooksResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -224,9 +252,18 @@ func (r *NotificationGoodreadsOwnedBooksResource) Read(ctx context.Context, req
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationGoodreadsOwnedBooksResourceName, response.GetImplementation(), response.GetConfigContract(), notificationGoodreadsOwnedBooksImplementation, notificationGoodreadsOwnedBooksConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationGoodreadsOwnedBooksResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -241,7 +278,13 @@ func (r *NotificationGoodreadsOwnedBooksResource) Update(ctx context.Context, re
 	}
 
 	// Update NotificationGoodreadsOwnedBooks
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -253,6 +296,7 @@ func (r *NotificationGoodreadsOwnedBooksResource) Update(ctx context.Context, re
 	tflog.Trace(ctx, "updated "+notificationGoodreadsOwnedBooksResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -278,10 +322,16 @@ func (r *NotificationGoodreadsOwnedBooksResource) Delete(ctx context.Context, re
 }
 
 func (r *NotificationGoodreadsOwnedBooksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationGoodreadsOwnedBooksResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationGoodreadsOwnedBooksImplementation))
 	tflog.Trace(ctx, "imported "+notificationGoodreadsOwnedBooksResourceName+": "+req.ID)
 }
 
+func (r *NotificationGoodreadsOwnedBooksResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationGoodreadsOwnedBooksResourceName, notificationGoodreadsOwnedBooksImplementation, notificationGoodreadsOwnedBooksConfigContract, &NotificationGoodreadsOwnedBooks{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationGoodreadsOwnedBooks) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
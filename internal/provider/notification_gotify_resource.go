@@ -28,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationGotifyResource{}
 	_ resource.ResourceWithImportState = &NotificationGotifyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationGotifyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGotifyResource{}
 )
 
 func NewNotificationGotifyResource() resource.Resource {
@@ -36,12 +38,16 @@ func NewNotificationGotifyResource() resource.Resource {
 
 // NotificationGotifyResource defines the notification implementation.
 type NotificationGotifyResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationGotify describes the notification data model.
 type NotificationGotify struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	AppToken                   types.String `tfsdk:"app_token"`
 	Server                     types.String `tfsdk:"server"`
 	Name                       types.String `tfsdk:"name"`
@@ -59,11 +65,13 @@ type NotificationGotify struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationGotify) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		AppToken:                   n.AppToken,
 		Server:                     n.Server,
 		Name:                       n.Name,
@@ -88,6 +96,7 @@ func (n NotificationGotify) toNotification() *Notification {
 
 func (n *NotificationGotify) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.AppToken = notification.AppToken
 	n.Server = notification.Server
 	n.Name = notification.Name
@@ -115,6 +124,10 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Gotify resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Gotify](https://wiki.servarr.com/readarr/supported#gotify).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -185,6 +198,8 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -214,11 +229,17 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *NotificationGotifyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationGotifyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationGotify
@@ -230,9 +251,15 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Create new NotificationGotify
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationGotifyResourceName, err))
 
@@ -242,6 +269,7 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -263,9 +291,18 @@ func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationGotifyResourceName, response.GetImplementation(), response.GetConfigContract(), notificationGotifyImplementation, notificationGotifyConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -280,7 +317,13 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 	}
 
 	// Update NotificationGotify
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -292,6 +335,7 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -317,10 +361,16 @@ func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationGotifyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationGotifyResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationGotifyImplementation))
 	tflog.Trace(ctx, "imported "+notificationGotifyResourceName+": "+req.ID)
 }
 
+func (r *NotificationGotifyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationGotifyResourceName, notificationGotifyImplementation, notificationGotifyConfigContract, &NotificationGotify{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationGotify) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -28,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationJoinResource{}
 	_ resource.ResourceWithImportState = &NotificationJoinResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationJoinResource{}
+	_ resource.ResourceWithMoveState   = &NotificationJoinResource{}
 )
 
 func NewNotificationJoinResource() resource.Resource {
@@ -36,12 +38,16 @@ func NewNotificationJoinResource() resource.Resource {
 
 // NotificationJoinResource defines the notification implementation.
 type NotificationJoinResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationJoin describes the notification data model.
 type NotificationJoin struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	APIKey                     types.String `tfsdk:"api_key"`
 	DeviceNames                types.String `tfsdk:"device_names"`
 	Name                       types.String `tfsdk:"name"`
@@ -57,11 +63,13 @@ type NotificationJoin struct {
 	OnBookDelete               types.Bool   `tfsdk:"on_book_delete"`
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationJoin) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		APIKey:                     n.APIKey,
 		DeviceNames:                n.DeviceNames,
 		Name:                       n.Name,
@@ -84,6 +92,7 @@ func (n NotificationJoin) toNotification() *Notification {
 
 func (n *NotificationJoin) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.APIKey = notification.APIKey
 	n.DeviceNames = notification.DeviceNames
 	n.Name = notification.Name
@@ -109,6 +118,10 @@ func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Join resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Join](https://wiki.servarr.com/readarr/supported#join).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -169,6 +182,8 @@ func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -198,11 +213,17 @@ func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRe
 }
 
 func (r *NotificationJoinResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationJoinResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationJoinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationJoin
@@ -214,9 +235,15 @@ func (r *NotificationJoinResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Create new NotificationJoin
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationJoinResourceName, err))
 
@@ -226,6 +253,7 @@ func (r *NotificationJoinResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created "+notificationJoinResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -247,9 +275,18 @@ func (r *NotificationJoinResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationJoinResourceName, response.GetImplementation(), response.GetConfigContract(), notificationJoinImplementation, notificationJoinConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationJoinResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -264,7 +301,13 @@ func (r *NotificationJoinResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Update NotificationJoin
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -276,6 +319,7 @@ func (r *NotificationJoinResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "updated "+notificationJoinResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -301,10 +345,16 @@ func (r *NotificationJoinResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationJoinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationJoinResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationJoinImplementation))
 	tflog.Trace(ctx, "imported "+notificationJoinResourceName+": "+req.ID)
 }
 
+func (r *NotificationJoinResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationJoinResourceName, notificationJoinImplementation, notificationJoinConfigContract, &NotificationJoin{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationJoin) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationKavitaResource{}
 	_ resource.ResourceWithImportState = &NotificationKavitaResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationKavitaResource{}
+	_ resource.ResourceWithMoveState   = &NotificationKavitaResource{}
 )
 
 func NewNotificationKavitaResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationKavitaResource() resource.Resource {
 
 // NotificationKavitaResource defines the notification implementation.
 type NotificationKavitaResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationKavita describes the notification data model.
 type NotificationKavita struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	APIKey                     types.String `tfsdk:"api_key"`
 	Host                       types.String `tfsdk:"host"`
 	Name                       types.String `tfsdk:"name"`
@@ -53,11 +59,13 @@ type NotificationKavita struct {
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationKavita) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		APIKey:                     n.APIKey,
 		Host:                       n.Host,
 		Name:                       n.Name,
@@ -78,6 +86,7 @@ func (n NotificationKavita) toNotification() *Notification {
 
 func (n *NotificationKavita) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.APIKey = notification.APIKey
 	n.Host = notification.Host
 	n.Name = notification.Name
@@ -101,6 +110,10 @@ func (r *NotificationKavitaResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Kavita resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Kavita](https://wiki.servarr.com/readarr/supported#kavita).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
 				Optional:            true,
@@ -141,6 +154,8 @@ func (r *NotificationKavitaResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -176,11 +191,17 @@ func (r *NotificationKavitaResource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *NotificationKavitaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationKavitaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationKavitaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationKavita
@@ -192,9 +213,15 @@ func (r *NotificationKavitaResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Create new NotificationKavita
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationKavitaResourceName, err))
 
@@ -204,6 +231,7 @@ func (r *NotificationKavitaResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created "+notificationKavitaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -225,9 +253,18 @@ func (r *NotificationKavitaResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationKavitaResourceName, response.GetImplementation(), response.GetConfigContract(), notificationKavitaImplementation, notificationKavitaConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationKavitaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -242,7 +279,13 @@ func (r *NotificationKavitaResource) Update(ctx context.Context, req resource.Up
 	}
 
 	// Update NotificationKavita
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -254,6 +297,7 @@ func (r *NotificationKavitaResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated "+notificationKavitaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -279,10 +323,16 @@ func (r *NotificationKavitaResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationKavitaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationKavitaResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationKavitaImplementation))
 	tflog.Trace(ctx, "imported "+notificationKavitaResourceName+": "+req.ID)
 }
 
+func (r *NotificationKavitaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationKavitaResourceName, notificationKavitaImplementation, notificationKavitaConfigContract, &NotificationKavita{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationKavita) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationMailgunResource{}
 	_ resource.ResourceWithImportState = &NotificationMailgunResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationMailgunResource{}
+	_ resource.ResourceWithMoveState   = &NotificationMailgunResource{}
 )
 
 func NewNotificationMailgunResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationMailgunResource() resource.Resource {
 
 // NotificationMailgunResource defines the notification implementation.
 type NotificationMailgunResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationMailgun describes the notification data model.
 type NotificationMailgun struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Recipients                 types.Set    `tfsdk:"recipients"`
 	From                       types.String `tfsdk:"from"`
 	SenderDomain               types.String `tfsdk:"sender_domain"`
@@ -57,11 +63,13 @@ type NotificationMailgun struct {
 	OnBookDelete               types.Bool   `tfsdk:"on_book_delete"`
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationMailgun) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		From:                       n.From,
 		Recipients:                 n.Recipients,
 		SenderDomain:               n.SenderDomain,
@@ -86,6 +94,7 @@ func (n NotificationMailgun) toNotification() *Notification {
 
 func (n *NotificationMailgun) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.From = notification.From
 	n.Recipients = notification.Recipients
 	n.SenderDomain = notification.SenderDomain
@@ -113,6 +122,10 @@ func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Mailgun resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Mailgun](https://wiki.servarr.com/readarr/supported#mailgun).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -173,6 +186,8 @@ func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -210,11 +225,17 @@ func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationMailgunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationMailgunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationMailgun
@@ -226,9 +247,15 @@ func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create new NotificationMailgun
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationMailgunResourceName, err))
 
@@ -238,6 +265,7 @@ func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+notificationMailgunResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -259,9 +287,18 @@ func (r *NotificationMailgunResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationMailgunResourceName, response.GetImplementation(), response.GetConfigContract(), notificationMailgunImplementation, notificationMailgunConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationMailgunResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -276,7 +313,13 @@ func (r *NotificationMailgunResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Update NotificationMailgun
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -288,6 +331,7 @@ func (r *NotificationMailgunResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+notificationMailgunResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -313,10 +357,16 @@ func (r *NotificationMailgunResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationMailgunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationMailgunResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationMailgunImplementation))
 	tflog.Trace(ctx, "imported "+notificationMailgunResourceName+": "+req.ID)
 }
 
+func (r *NotificationMailgunResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationMailgunResourceName, notificationMailgunImplementation, notificationMailgunConfigContract, &NotificationMailgun{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationMailgun) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
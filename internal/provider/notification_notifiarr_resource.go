@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationNotifiarrResource{}
 	_ resource.ResourceWithImportState = &NotificationNotifiarrResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationNotifiarrResource{}
+	_ resource.ResourceWithMoveState   = &NotificationNotifiarrResource{}
 )
 
 func NewNotificationNotifiarrResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationNotifiarrResource() resource.Resource {
 
 // NotificationNotifiarrResource defines the notification implementation.
 type NotificationNotifiarrResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationNotifiarr describes the notification data model.
 type NotificationNotifiarr struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	APIKey                     types.String `tfsdk:"api_key"`
 	Name                       types.String `tfsdk:"name"`
 	ID                         types.Int64  `tfsdk:"id"`
@@ -53,11 +59,13 @@ type NotificationNotifiarr struct {
 	OnBookDelete               types.Bool   `tfsdk:"on_book_delete"`
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationNotifiarr) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		APIKey:                     n.APIKey,
 		Name:                       n.Name,
 		ID:                         n.ID,
@@ -78,6 +86,7 @@ func (n NotificationNotifiarr) toNotification() *Notification {
 
 func (n *NotificationNotifiarr) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.APIKey = notification.APIKey
 	n.Name = notification.Name
 	n.ID = notification.ID
@@ -101,6 +110,10 @@ func (r *NotificationNotifiarrResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Notifiarr resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Notifiarr](https://wiki.servarr.com/readarr/supported#notifiarr).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -161,6 +174,8 @@ func (r *NotificationNotifiarrResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -179,11 +194,17 @@ func (r *NotificationNotifiarrResource) Schema(_ context.Context, _ resource.Sch
 }
 
 func (r *NotificationNotifiarrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationNotifiarrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationNotifiarr
@@ -195,9 +216,15 @@ func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource
 	}
 
 	// Create new NotificationNotifiarr
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationNotifiarrResourceName, err))
 
@@ -207,6 +234,7 @@ func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource
 	tflog.Trace(ctx, "created "+notificationNotifiarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -228,9 +256,18 @@ func (r *NotificationNotifiarrResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationNotifiarrResourceName, response.GetImplementation(), response.GetConfigContract(), notificationNotifiarrImplementation, notificationNotifiarrConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationNotifiarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -245,7 +282,13 @@ func (r *NotificationNotifiarrResource) Update(ctx context.Context, req resource
 	}
 
 	// Update NotificationNotifiarr
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -257,6 +300,7 @@ func (r *NotificationNotifiarrResource) Update(ctx context.Context, req resource
 	tflog.Trace(ctx, "updated "+notificationNotifiarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -282,10 +326,16 @@ func (r *NotificationNotifiarrResource) Delete(ctx context.Context, req resource
 }
 
 func (r *NotificationNotifiarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationNotifiarrResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationNotifiarrImplementation))
 	tflog.Trace(ctx, "imported "+notificationNotifiarrResourceName+": "+req.ID)
 }
 
+func (r *NotificationNotifiarrResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationNotifiarrResourceName, notificationNotifiarrImplementation, notificationNotifiarrConfigContract, &NotificationNotifiarr{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationNotifiarr) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
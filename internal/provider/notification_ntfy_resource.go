@@ -28,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationNtfyResource{}
 	_ resource.ResourceWithImportState = &NotificationNtfyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationNtfyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationNtfyResource{}
 )
 
 func NewNotificationNtfyResource() resource.Resource {
@@ -36,12 +38,16 @@ func NewNotificationNtfyResource() resource.Resource {
 
 // NotificationNtfyResource defines the notification implementation.
 type NotificationNtfyResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationNtfy describes the notification data model.
 type NotificationNtfy struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	FieldTags                  types.Set    `tfsdk:"field_tags"`
 	Topics                     types.Set    `tfsdk:"topics"`
 	ClickURL                   types.String `tfsdk:"click_url"`
@@ -61,11 +67,13 @@ type NotificationNtfy struct {
 	OnBookDelete               types.Bool   `tfsdk:"on_book_delete"`
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationNtfy) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		FieldTags:                  n.FieldTags,
 		Topics:                     n.Topics,
 		ServerURL:                  n.ServerURL,
@@ -92,6 +100,7 @@ func (n NotificationNtfy) toNotification() *Notification {
 
 func (n *NotificationNtfy) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.FieldTags = notification.FieldTags
 	n.Topics = notification.Topics
 	n.ServerURL = notification.ServerURL
@@ -121,6 +130,10 @@ func (r *NotificationNtfyResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Ntfy resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Ntfy](https://wiki.servarr.com/readarr/supported#ntfy).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -181,6 +194,8 @@ func (r *NotificationNtfyResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -234,11 +249,17 @@ func (r *NotificationNtfyResource) Schema(_ context.Context, _ resource.SchemaRe
 }
 
 func (r *NotificationNtfyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationNtfyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationNtfyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationNtfy
@@ -250,9 +271,15 @@ func (r *NotificationNtfyResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Create new NotificationNtfy
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationNtfyResourceName, err))
 
@@ -262,6 +289,7 @@ func (r *NotificationNtfyResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created "+notificationNtfyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -283,9 +311,18 @@ func (r *NotificationNtfyResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationNtfyResourceName, response.GetImplementation(), response.GetConfigContract(), notificationNtfyImplementation, notificationNtfyConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationNtfyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -300,7 +337,13 @@ func (r *NotificationNtfyResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Update NotificationNtfy
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -312,6 +355,7 @@ func (r *NotificationNtfyResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "updated "+notificationNtfyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -337,10 +381,16 @@ func (r *NotificationNtfyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationNtfyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationNtfyResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationNtfyImplementation))
 	tflog.Trace(ctx, "imported "+notificationNtfyResourceName+": "+req.ID)
 }
 
+func (r *NotificationNtfyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationNtfyResourceName, notificationNtfyImplementation, notificationNtfyConfigContract, &NotificationNtfy{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationNtfy) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -28,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationProwlResource{}
 	_ resource.ResourceWithImportState = &NotificationProwlResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationProwlResource{}
+	_ resource.ResourceWithMoveState   = &NotificationProwlResource{}
 )
 
 func NewNotificationProwlResource() resource.Resource {
@@ -36,12 +38,16 @@ func NewNotificationProwlResource() resource.Resource {
 
 // NotificationProwlResource defines the notification implementation.
 type NotificationProwlResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationProwl describes the notification data model.
 type NotificationProwl struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	APIKey                     types.String `tfsdk:"api_key"`
 	Name                       types.String `tfsdk:"name"`
 	Priority                   types.Int64  `tfsdk:"priority"`
@@ -56,11 +62,13 @@ type NotificationProwl struct {
 	OnBookDelete               types.Bool   `tfsdk:"on_book_delete"`
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationProwl) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		APIKey:                     n.APIKey,
 		Name:                       n.Name,
 		Priority:                   n.Priority,
@@ -82,6 +90,7 @@ func (n NotificationProwl) toNotification() *Notification {
 
 func (n *NotificationProwl) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.APIKey = notification.APIKey
 	n.Name = notification.Name
 	n.Priority = notification.Priority
@@ -106,6 +115,10 @@ func (r *NotificationProwlResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Prowl resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Prowl](https://wiki.servarr.com/readarr/supported#prowl).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -166,6 +179,8 @@ func (r *NotificationProwlResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -192,11 +207,17 @@ func (r *NotificationProwlResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *NotificationProwlResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationProwlResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationProwlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationProwl
@@ -208,9 +229,15 @@ func (r *NotificationProwlResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create new NotificationProwl
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationProwlResourceName, err))
 
@@ -220,6 +247,7 @@ func (r *NotificationProwlResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+notificationProwlResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -241,9 +269,18 @@ func (r *NotificationProwlResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationProwlResourceName, response.GetImplementation(), response.GetConfigContract(), notificationProwlImplementation, notificationProwlConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationProwlResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -258,7 +295,13 @@ func (r *NotificationProwlResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update NotificationProwl
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -270,6 +313,7 @@ func (r *NotificationProwlResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+notificationProwlResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -295,10 +339,16 @@ func (r *NotificationProwlResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationProwlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationProwlResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationProwlImplementation))
 	tflog.Trace(ctx, "imported "+notificationProwlResourceName+": "+req.ID)
 }
 
+func (r *NotificationProwlResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationProwlResourceName, notificationProwlImplementation, notificationProwlConfigContract, &NotificationProwl{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationProwl) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
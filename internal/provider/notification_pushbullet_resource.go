@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationPushbulletResource{}
 	_ resource.ResourceWithImportState = &NotificationPushbulletResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationPushbulletResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPushbulletResource{}
 )
 
 func NewNotificationPushbulletResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationPushbulletResource() resource.Resource {
 
 // NotificationPushbulletResource defines the notification implementation.
 type NotificationPushbulletResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationPushbullet describes the notification data model.
 type NotificationPushbullet struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	DeviceIds                  types.Set    `tfsdk:"device_ids"`
 	ChannelTags                types.Set    `tfsdk:"channel_tags"`
 	SenderID                   types.String `tfsdk:"sender_id"`
@@ -58,11 +64,13 @@ type NotificationPushbullet struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationPushbullet) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		DeviceIds:                  n.DeviceIds,
 		ChannelTags:                n.ChannelTags,
 		SenderID:                   n.SenderID,
@@ -88,6 +96,7 @@ func (n NotificationPushbullet) toNotification() *Notification {
 
 func (n *NotificationPushbullet) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.DeviceIds = notification.DeviceIds
 	n.ChannelTags = notification.ChannelTags
 	n.SenderID = notification.SenderID
@@ -116,6 +125,10 @@ func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Pushbullet resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Pushbullet](https://wiki.servarr.com/readarr/supported#pushbullet).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -186,6 +199,8 @@ func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -221,11 +236,17 @@ func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (r *NotificationPushbulletResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationPushbulletResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationPushbulletResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationPushbullet
@@ -237,9 +258,15 @@ func (r *NotificationPushbulletResource) Create(ctx context.Context, req resourc
 	}
 
 	// Create new NotificationPushbullet
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushbulletResourceName, err))
 
@@ -249,6 +276,7 @@ func (r *NotificationPushbulletResource) Create(ctx context.Context, req resourc
 	tflog.Trace(ctx, "created "+notificationPushbulletResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -270,9 +298,18 @@ func (r *NotificationPushbulletResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationPushbulletResourceName, response.GetImplementation(), response.GetConfigContract(), notificationPushbulletImplementation, notificationPushbulletConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationPushbulletResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -287,7 +324,13 @@ func (r *NotificationPushbulletResource) Update(ctx context.Context, req resourc
 	}
 
 	// Update NotificationPushbullet
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -299,6 +342,7 @@ func (r *NotificationPushbulletResource) Update(ctx context.Context, req resourc
 	tflog.Trace(ctx, "updated "+notificationPushbulletResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -324,10 +368,16 @@ func (r *NotificationPushbulletResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *NotificationPushbulletResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationPushbulletResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationPushbulletImplementation))
 	tflog.Trace(ctx, "imported "+notificationPushbulletResourceName+": "+req.ID)
 }
 
+func (r *NotificationPushbulletResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationPushbulletResourceName, notificationPushbulletImplementation, notificationPushbulletConfigContract, &NotificationPushbullet{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationPushbullet) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
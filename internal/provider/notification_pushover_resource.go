@@ -28,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationPushoverResource{}
 	_ resource.ResourceWithImportState = &NotificationPushoverResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationPushoverResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPushoverResource{}
 )
 
 func NewNotificationPushoverResource() resource.Resource {
@@ -36,12 +38,16 @@ func NewNotificationPushoverResource() resource.Resource {
 
 // NotificationPushoverResource defines the notification implementation.
 type NotificationPushoverResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationPushover describes the notification data model.
 type NotificationPushover struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Devices                    types.Set    `tfsdk:"devices"`
 	Sound                      types.String `tfsdk:"sound"`
 	Name                       types.String `tfsdk:"name"`
@@ -63,11 +69,13 @@ type NotificationPushover struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationPushover) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		Devices:                    n.Devices,
 		Sound:                      n.Sound,
 		APIKey:                     n.APIKey,
@@ -96,6 +104,7 @@ func (n NotificationPushover) toNotification() *Notification {
 
 func (n *NotificationPushover) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Devices = notification.Devices
 	n.Sound = notification.Sound
 	n.APIKey = notification.APIKey
@@ -127,6 +136,10 @@ func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Pushover resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Pushover](https://wiki.servarr.com/readarr/supported#pushover).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -197,6 +210,8 @@ func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -249,11 +264,17 @@ func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *NotificationPushoverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationPushoverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationPushover
@@ -265,9 +286,15 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 	}
 
 	// Create new NotificationPushover
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushoverResourceName, err))
 
@@ -277,6 +304,7 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -298,9 +326,18 @@ func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationPushoverResourceName, response.GetImplementation(), response.GetConfigContract(), notificationPushoverImplementation, notificationPushoverConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -315,7 +352,13 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 	}
 
 	// Update NotificationPushover
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -327,6 +370,7 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -352,10 +396,16 @@ func (r *NotificationPushoverResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationPushoverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationPushoverResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationPushoverImplementation))
 	tflog.Trace(ctx, "imported "+notificationPushoverResourceName+": "+req.ID)
 }
 
+func (r *NotificationPushoverResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationPushoverResourceName, notificationPushoverImplementation, notificationPushoverConfigContract, &NotificationPushover{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationPushover) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"strconv"
 
 	"github.com/devopsarr/readarr-go/readarr"
@@ -25,6 +26,7 @@ const notificationResourceName = "notification"
 var (
 	_ resource.Resource                = &NotificationResource{}
 	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationResource{}
 )
 
 var notificationFields = helpers.Fields{
@@ -41,12 +43,15 @@ func NewNotificationResource() resource.Resource {
 
 // NotificationResource defines the notification implementation.
 type NotificationResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // Notification describes the notification data model.
 type Notification struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
 	AddIds                     types.Set    `tfsdk:"add_ids"`
 	RemoveIds                  types.Set    `tfsdk:"remove_ids"`
 	FieldTags                  types.Set    `tfsdk:"field_tags"`
@@ -137,10 +142,107 @@ type Notification struct {
 	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
 }
 
+// ManagedNotification describes the notification resource data model, the Notification fields plus the resource options.
+type ManagedNotification struct {
+	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
+	AddIds                     types.Set    `tfsdk:"add_ids"`
+	RemoveIds                  types.Set    `tfsdk:"remove_ids"`
+	FieldTags                  types.Set    `tfsdk:"field_tags"`
+	ChannelTags                types.Set    `tfsdk:"channel_tags"`
+	Topics                     types.Set    `tfsdk:"topics"`
+	DeviceIds                  types.Set    `tfsdk:"device_ids"`
+	Devices                    types.Set    `tfsdk:"devices"`
+	To                         types.Set    `tfsdk:"to"`
+	Cc                         types.Set    `tfsdk:"cc"`
+	Bcc                        types.Set    `tfsdk:"bcc"`
+	Recipients                 types.Set    `tfsdk:"recipients"`
+	DeviceNames                types.String `tfsdk:"device_names"`
+	AccessToken                types.String `tfsdk:"access_token"`
+	Host                       types.String `tfsdk:"host"`
+	InstanceName               types.String `tfsdk:"instance_name"`
+	Name                       types.String `tfsdk:"name"`
+	Implementation             types.String `tfsdk:"implementation"`
+	ConfigContract             types.String `tfsdk:"config_contract"`
+	ClickURL                   types.String `tfsdk:"click_url"`
+	ConsumerSecret             types.String `tfsdk:"consumer_secret"`
+	Path                       types.String `tfsdk:"path"`
+	Arguments                  types.String `tfsdk:"arguments"`
+	ConsumerKey                types.String `tfsdk:"consumer_key"`
+	ChatID                     types.String `tfsdk:"chat_id"`
+	From                       types.String `tfsdk:"from"`
+	Icon                       types.String `tfsdk:"icon"`
+	Password                   types.String `tfsdk:"password"`
+	Event                      types.String `tfsdk:"event"`
+	Key                        types.String `tfsdk:"key"`
+	RefreshToken               types.String `tfsdk:"refresh_token"`
+	WebHookURL                 types.String `tfsdk:"web_hook_url"`
+	Username                   types.String `tfsdk:"username"`
+	UserID                     types.String `tfsdk:"user_id"`
+	UserKey                    types.String `tfsdk:"user_key"`
+	Mention                    types.String `tfsdk:"mention"`
+	Avatar                     types.String `tfsdk:"avatar"`
+	URL                        types.String `tfsdk:"url"`
+	URLBase                    types.String `tfsdk:"url_base"`
+	Token                      types.String `tfsdk:"token"`
+	Sound                      types.String `tfsdk:"sound"`
+	SignIn                     types.String `tfsdk:"sign_in"`
+	Server                     types.String `tfsdk:"server"`
+	SenderID                   types.String `tfsdk:"sender_id"`
+	BotToken                   types.String `tfsdk:"bot_token"`
+	SenderDomain               types.String `tfsdk:"sender_domain"`
+	MapTo                      types.String `tfsdk:"map_to"`
+	MapFrom                    types.String `tfsdk:"map_from"`
+	Channel                    types.String `tfsdk:"channel"`
+	ServerURL                  types.String `tfsdk:"server_url"`
+	AccessTokenSecret          types.String `tfsdk:"access_token_secret"`
+	RequestTokenSecret         types.String `tfsdk:"request_token_secret"`
+	Description                types.String `tfsdk:"description"`
+	Location                   types.String `tfsdk:"location"`
+	APIKey                     types.String `tfsdk:"api_key"`
+	AppToken                   types.String `tfsdk:"app_token"`
+	Author                     types.String `tfsdk:"author"`
+	AuthUser                   types.String `tfsdk:"auth_user"`
+	Priority                   types.Int64  `tfsdk:"priority"`
+	Port                       types.Int64  `tfsdk:"port"`
+	Method                     types.Int64  `tfsdk:"method"`
+	Retry                      types.Int64  `tfsdk:"retry"`
+	Condition                  types.Int64  `tfsdk:"condition"`
+	Expire                     types.Int64  `tfsdk:"expire"`
+	ID                         types.Int64  `tfsdk:"id"`
+	ImportFields               types.Int64  `tfsdk:"import_fields"`
+	GrabFields                 types.Int64  `tfsdk:"grab_fields"`
+	AttachFiles                types.Bool   `tfsdk:"attach_files"`
+	OnGrab                     types.Bool   `tfsdk:"on_grab"`
+	SendSilently               types.Bool   `tfsdk:"send_silently"`
+	OnHealthIssue              types.Bool   `tfsdk:"on_health_issue"`
+	OnApplicationUpdate        types.Bool   `tfsdk:"on_application_update"`
+	DirectMessage              types.Bool   `tfsdk:"direct_message"`
+	RequireEncryption          types.Bool   `tfsdk:"require_encryption"`
+	UseSSL                     types.Bool   `tfsdk:"use_ssl"`
+	Notify                     types.Bool   `tfsdk:"notify"`
+	UseEuEndpoint              types.Bool   `tfsdk:"use_eu_endpoint"`
+	UpdateLibrary              types.Bool   `tfsdk:"update_library"`
+	IncludeHealthWarnings      types.Bool   `tfsdk:"include_health_warnings"`
+	OnRename                   types.Bool   `tfsdk:"on_rename"`
+	OnUpgrade                  types.Bool   `tfsdk:"on_upgrade"`
+	OnReleaseImport            types.Bool   `tfsdk:"on_release_import"`
+	OnAuthorDelete             types.Bool   `tfsdk:"on_author_delete"`
+	OnBookDelete               types.Bool   `tfsdk:"on_book_delete"`
+	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
+	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
+	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
+	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                            types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                      types.SetType{}.WithElementType(types.StringType),
 			"field_tags":                      types.SetType{}.WithElementType(types.StringType),
 			"recipients":                      types.SetType{}.WithElementType(types.StringType),
 			"devices":                         types.SetType{}.WithElementType(types.StringType),
@@ -240,6 +342,10 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -328,6 +434,8 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -720,14 +828,20 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *NotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var notification *Notification
+	var notification *ManagedNotification
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -736,9 +850,15 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Create new Notification
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
 
@@ -748,15 +868,17 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state ManagedNotification
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = notification.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var notification *Notification
+	var notification *ManagedNotification
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &notification)...)
 
@@ -775,15 +897,18 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state ManagedNotification
 
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = notification.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var notification *Notification
+	var notification *ManagedNotification
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -792,7 +917,13 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Update Notification
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -804,9 +935,11 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state ManagedNotification
 
 	state.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &state.Tags, &state.TagLabels, &state.TagsAll, &resp.Diagnostics)
+	state.AdoptExisting = notification.AdoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -832,7 +965,7 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationResourceName, path.Root("id"), req, resp, notificationLookup(r.client, ""))
 	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
 }
 
@@ -899,3 +1032,242 @@ func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *reada
 
 	return notification
 }
+
+// notificationLookup returns an import lookup by name for notifications, restricted to the given implementation if not empty.
+func notificationLookup(client *readarr.APIClient, implementation string) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.NotificationApi.ListNotification(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name && (implementation == "" || item.GetImplementation() == implementation) {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
+
+func (n ManagedNotification) toNotification() *Notification {
+	return &Notification{
+		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
+		AddIds:                     n.AddIds,
+		RemoveIds:                  n.RemoveIds,
+		FieldTags:                  n.FieldTags,
+		ChannelTags:                n.ChannelTags,
+		Topics:                     n.Topics,
+		DeviceIds:                  n.DeviceIds,
+		Devices:                    n.Devices,
+		To:                         n.To,
+		Cc:                         n.Cc,
+		Bcc:                        n.Bcc,
+		Recipients:                 n.Recipients,
+		DeviceNames:                n.DeviceNames,
+		AccessToken:                n.AccessToken,
+		Host:                       n.Host,
+		InstanceName:               n.InstanceName,
+		Name:                       n.Name,
+		Implementation:             n.Implementation,
+		ConfigContract:             n.ConfigContract,
+		ClickURL:                   n.ClickURL,
+		ConsumerSecret:             n.ConsumerSecret,
+		Path:                       n.Path,
+		Arguments:                  n.Arguments,
+		ConsumerKey:                n.ConsumerKey,
+		ChatID:                     n.ChatID,
+		From:                       n.From,
+		Icon:                       n.Icon,
+		Password:                   n.Password,
+		Event:                      n.Event,
+		Key:                        n.Key,
+		RefreshToken:               n.RefreshToken,
+		WebHookURL:                 n.WebHookURL,
+		Username:                   n.Username,
+		UserID:                     n.UserID,
+		UserKey:                    n.UserKey,
+		Mention:                    n.Mention,
+		Avatar:                     n.Avatar,
+		URL:                        n.URL,
+		URLBase:                    n.URLBase,
+		Token:                      n.Token,
+		Sound:                      n.Sound,
+		SignIn:                     n.SignIn,
+		Server:                     n.Server,
+		SenderID:                   n.SenderID,
+		BotToken:                   n.BotToken,
+		SenderDomain:               n.SenderDomain,
+		MapTo:                      n.MapTo,
+		MapFrom:                    n.MapFrom,
+		Channel:                    n.Channel,
+		ServerURL:                  n.ServerURL,
+		AccessTokenSecret:          n.AccessTokenSecret,
+		RequestTokenSecret:         n.RequestTokenSecret,
+		Description:                n.Description,
+		Location:                   n.Location,
+		APIKey:                     n.APIKey,
+		AppToken:                   n.AppToken,
+		Author:                     n.Author,
+		AuthUser:                   n.AuthUser,
+		Priority:                   n.Priority,
+		Port:                       n.Port,
+		Method:                     n.Method,
+		Retry:                      n.Retry,
+		Condition:                  n.Condition,
+		Expire:                     n.Expire,
+		ID:                         n.ID,
+		ImportFields:               n.ImportFields,
+		GrabFields:                 n.GrabFields,
+		AttachFiles:                n.AttachFiles,
+		OnGrab:                     n.OnGrab,
+		SendSilently:               n.SendSilently,
+		OnHealthIssue:              n.OnHealthIssue,
+		OnApplicationUpdate:        n.OnApplicationUpdate,
+		DirectMessage:              n.DirectMessage,
+		RequireEncryption:          n.RequireEncryption,
+		UseSSL:                     n.UseSSL,
+		Notify:                     n.Notify,
+		UseEuEndpoint:              n.UseEuEndpoint,
+		UpdateLibrary:              n.UpdateLibrary,
+		IncludeHealthWarnings:      n.IncludeHealthWarnings,
+		OnRename:                   n.OnRename,
+		OnUpgrade:                  n.OnUpgrade,
+		OnReleaseImport:            n.OnReleaseImport,
+		OnAuthorDelete:             n.OnAuthorDelete,
+		OnBookDelete:               n.OnBookDelete,
+		OnBookFileDelete:           n.OnBookFileDelete,
+		OnBookFileDeleteForUpgrade: n.OnBookFileDeleteForUpgrade,
+		OnDownloadFailure:          n.OnDownloadFailure,
+		OnImportFailure:            n.OnImportFailure,
+		OnBookRetag:                n.OnBookRetag,
+	}
+}
+
+func (n *ManagedNotification) fromNotification(notification *Notification) {
+	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
+	n.AddIds = notification.AddIds
+	n.RemoveIds = notification.RemoveIds
+	n.FieldTags = notification.FieldTags
+	n.ChannelTags = notification.ChannelTags
+	n.Topics = notification.Topics
+	n.DeviceIds = notification.DeviceIds
+	n.Devices = notification.Devices
+	n.To = notification.To
+	n.Cc = notification.Cc
+	n.Bcc = notification.Bcc
+	n.Recipients = notification.Recipients
+	n.DeviceNames = notification.DeviceNames
+	n.AccessToken = notification.AccessToken
+	n.Host = notification.Host
+	n.InstanceName = notification.InstanceName
+	n.Name = notification.Name
+	n.Implementation = notification.Implementation
+	n.ConfigContract = notification.ConfigContract
+	n.ClickURL = notification.ClickURL
+	n.ConsumerSecret = notification.ConsumerSecret
+	n.Path = notification.Path
+	n.Arguments = notification.Arguments
+	n.ConsumerKey = notification.ConsumerKey
+	n.ChatID = notification.ChatID
+	n.From = notification.From
+	n.Icon = notification.Icon
+	n.Password = notification.Password
+	n.Event = notification.Event
+	n.Key = notification.Key
+	n.RefreshToken = notification.RefreshToken
+	n.WebHookURL = notification.WebHookURL
+	n.Username = notification.Username
+	n.UserID = notification.UserID
+	n.UserKey = notification.UserKey
+	n.Mention = notification.Mention
+	n.Avatar = notification.Avatar
+	n.URL = notification.URL
+	n.URLBase = notification.URLBase
+	n.Token = notification.Token
+	n.Sound = notification.Sound
+	n.SignIn = notification.SignIn
+	n.Server = notification.Server
+	n.SenderID = notification.SenderID
+	n.BotToken = notification.BotToken
+	n.SenderDomain = notification.SenderDomain
+	n.MapTo = notification.MapTo
+	n.MapFrom = notification.MapFrom
+	n.Channel = notification.Channel
+	n.ServerURL = notification.ServerURL
+	n.AccessTokenSecret = notification.AccessTokenSecret
+	n.RequestTokenSecret = notification.RequestTokenSecret
+	n.Description = notification.Description
+	n.Location = notification.Location
+	n.APIKey = notification.APIKey
+	n.AppToken = notification.AppToken
+	n.Author = notification.Author
+	n.AuthUser = notification.AuthUser
+	n.Priority = notification.Priority
+	n.Port = notification.Port
+	n.Method = notification.Method
+	n.Retry = notification.Retry
+	n.Condition = notification.Condition
+	n.Expire = notification.Expire
+	n.ID = notification.ID
+	n.ImportFields = notification.ImportFields
+	n.GrabFields = notification.GrabFields
+	n.AttachFiles = notification.AttachFiles
+	n.OnGrab = notification.OnGrab
+	n.SendSilently = notification.SendSilently
+	n.OnHealthIssue = notification.OnHealthIssue
+	n.OnApplicationUpdate = notification.OnApplicationUpdate
+	n.DirectMessage = notification.DirectMessage
+	n.RequireEncryption = notification.RequireEncryption
+	n.UseSSL = notification.UseSSL
+	n.Notify = notification.Notify
+	n.UseEuEndpoint = notification.UseEuEndpoint
+	n.UpdateLibrary = notification.UpdateLibrary
+	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
+	n.OnRename = notification.OnRename
+	n.OnUpgrade = notification.OnUpgrade
+	n.OnReleaseImport = notification.OnReleaseImport
+	n.OnAuthorDelete = notification.OnAuthorDelete
+	n.OnBookDelete = notification.OnBookDelete
+	n.OnBookFileDelete = notification.OnBookFileDelete
+	n.OnBookFileDeleteForUpgrade = notification.OnBookFileDeleteForUpgrade
+	n.OnDownloadFailure = notification.OnDownloadFailure
+	n.OnImportFailure = notification.OnImportFailure
+	n.OnBookRetag = notification.OnBookRetag
+}
+
+func (n *ManagedNotification) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
+	genericNotification := n.toNotification()
+	genericNotification.write(ctx, notification, diags)
+	n.fromNotification(genericNotification)
+}
+
+func (n *ManagedNotification) read(ctx context.Context, diags *diag.Diagnostics) *readarr.NotificationResource {
+	return n.toNotification().read(ctx, diags)
+}
+
+// createNotification creates a notification, or updates the existing one with the same name and implementation if adopt is set.
+func createNotification(ctx context.Context, client *readarr.APIClient, request *readarr.NotificationResource, adopt bool) (*readarr.NotificationResource, error) {
+	return helpers.CreateOrAdopt(ctx, adopt, request.GetName(), notificationLookup(client, request.GetImplementation()),
+		func() (*readarr.NotificationResource, *http.Response, error) {
+			return client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+		},
+		func(id int32) (*readarr.NotificationResource, *http.Response, error) {
+			request.SetId(id)
+
+			return client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(id))).NotificationResource(*request).Execute()
+		},
+	)
+}
+
+// notificationStateMover returns the state mover from the generic notification resource to a typed one.
+func notificationStateMover(ctx context.Context, resourceName, implementation, configContract string, target interface{ fromNotification(*Notification) }) resource.StateMover {
+	return helpers.GenericStateMover(ctx, &NotificationResource{}, notificationResourceName, resourceName, implementation, configContract, func(notification *ManagedNotification) interface{} {
+		target.fromNotification(notification.toNotification())
+
+		return target
+	})
+}
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationSendgridResource{}
 	_ resource.ResourceWithImportState = &NotificationSendgridResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSendgridResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSendgridResource{}
 )
 
 func NewNotificationSendgridResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationSendgridResource() resource.Resource {
 
 // NotificationSendgridResource defines the notification implementation.
 type NotificationSendgridResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationSendgrid describes the notification data model.
 type NotificationSendgrid struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Recipients                 types.Set    `tfsdk:"recipients"`
 	From                       types.String `tfsdk:"from"`
 	Name                       types.String `tfsdk:"name"`
@@ -57,11 +63,13 @@ type NotificationSendgrid struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationSendgrid) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		Recipients:                 n.Recipients,
 		APIKey:                     n.APIKey,
 		Name:                       n.Name,
@@ -86,6 +94,7 @@ func (n NotificationSendgrid) toNotification() *Notification {
 
 func (n *NotificationSendgrid) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Recipients = notification.Recipients
 	n.APIKey = notification.APIKey
 	n.Name = notification.Name
@@ -113,6 +122,10 @@ func (r *NotificationSendgridResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Sendgrid resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Sendgrid](https://wiki.servarr.com/readarr/supported#sendgrid).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -183,6 +196,8 @@ func (r *NotificationSendgridResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -211,11 +226,17 @@ func (r *NotificationSendgridResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *NotificationSendgridResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationSendgridResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationSendgrid
@@ -227,9 +248,15 @@ func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.
 	}
 
 	// Create new NotificationSendgrid
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSendgridResourceName, err))
 
@@ -239,6 +266,7 @@ func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationSendgridResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -260,9 +288,18 @@ func (r *NotificationSendgridResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationSendgridResourceName, response.GetImplementation(), response.GetConfigContract(), notificationSendgridImplementation, notificationSendgridConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationSendgridResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -277,7 +314,13 @@ func (r *NotificationSendgridResource) Update(ctx context.Context, req resource.
 	}
 
 	// Update NotificationSendgrid
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -289,6 +332,7 @@ func (r *NotificationSendgridResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationSendgridResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -314,10 +358,16 @@ func (r *NotificationSendgridResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationSendgridResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationSendgridResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationSendgridImplementation))
 	tflog.Trace(ctx, "imported "+notificationSendgridResourceName+": "+req.ID)
 }
 
+func (r *NotificationSendgridResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationSendgridResourceName, notificationSendgridImplementation, notificationSendgridConfigContract, &NotificationSendgrid{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationSendgrid) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationSlackResource{}
 	_ resource.ResourceWithImportState = &NotificationSlackResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSlackResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSlackResource{}
 )
 
 func NewNotificationSlackResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationSlackResource() resource.Resource {
 
 // NotificationSlackResource defines the notification implementation.
 type NotificationSlackResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationSlack describes the notification data model.
 type NotificationSlack struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	WebHookURL                 types.String `tfsdk:"web_hook_url"`
 	Name                       types.String `tfsdk:"name"`
 	Username                   types.String `tfsdk:"username"`
@@ -60,11 +66,13 @@ type NotificationSlack struct {
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
 	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationSlack) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		WebHookURL:                 n.WebHookURL,
 		Icon:                       n.Icon,
 		Username:                   n.Username,
@@ -92,6 +100,7 @@ func (n NotificationSlack) toNotification() *Notification {
 
 func (n *NotificationSlack) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.WebHookURL = notification.WebHookURL
 	n.Icon = notification.Icon
 	n.Username = notification.Username
@@ -122,6 +131,10 @@ func (r *NotificationSlackResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Slack resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Slack](https://wiki.servarr.com/readarr/supported#slack).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -202,6 +215,8 @@ func (r *NotificationSlackResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -233,11 +248,17 @@ func (r *NotificationSlackResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *NotificationSlackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationSlackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationSlackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationSlack
@@ -249,9 +270,15 @@ func (r *NotificationSlackResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create new NotificationSlack
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSlackResourceName, err))
 
@@ -261,6 +288,7 @@ func (r *NotificationSlackResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+notificationSlackResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -282,9 +310,18 @@ func (r *NotificationSlackResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationSlackResourceName, response.GetImplementation(), response.GetConfigContract(), notificationSlackImplementation, notificationSlackConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationSlackResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -299,7 +336,13 @@ func (r *NotificationSlackResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update NotificationSlack
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -311,6 +354,7 @@ func (r *NotificationSlackResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+notificationSlackResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -336,10 +380,16 @@ func (r *NotificationSlackResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationSlackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationSlackResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationSlackImplementation))
 	tflog.Trace(ctx, "imported "+notificationSlackResourceName+": "+req.ID)
 }
 
+func (r *NotificationSlackResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationSlackResourceName, notificationSlackImplementation, notificationSlackConfigContract, &NotificationSlack{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationSlack) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
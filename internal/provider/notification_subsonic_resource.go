@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationSubsonicResource{}
 	_ resource.ResourceWithImportState = &NotificationSubsonicResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSubsonicResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSubsonicResource{}
 )
 
 func NewNotificationSubsonicResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationSubsonicResource() resource.Resource {
 
 // NotificationSubsonicResource defines the notification implementation.
 type NotificationSubsonicResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationSubsonic describes the notification data model.
 type NotificationSubsonic struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Host                       types.String `tfsdk:"host"`
 	Name                       types.String `tfsdk:"name"`
 	Username                   types.String `tfsdk:"username"`
@@ -62,11 +68,13 @@ type NotificationSubsonic struct {
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationSubsonic) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		Port:                       n.Port,
 		Host:                       n.Host,
 		URLBase:                    n.URLBase,
@@ -96,6 +104,7 @@ func (n NotificationSubsonic) toNotification() *Notification {
 
 func (n *NotificationSubsonic) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Port = notification.Port
 	n.URLBase = notification.URLBase
 	n.Host = notification.Host
@@ -128,6 +137,10 @@ func (r *NotificationSubsonicResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Subsonic resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Subsonic](https://wiki.servarr.com/readarr/supported#subsonic).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -198,6 +211,8 @@ func (r *NotificationSubsonicResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -250,11 +265,17 @@ func (r *NotificationSubsonicResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *NotificationSubsonicResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationSubsonicResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationSubsonicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationSubsonic
@@ -266,9 +287,15 @@ func (r *NotificationSubsonicResource) Create(ctx context.Context, req resource.
 	}
 
 	// Create new NotificationSubsonic
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSubsonicResourceName, err))
 
@@ -278,6 +305,7 @@ func (r *NotificationSubsonicResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationSubsonicResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -299,9 +327,18 @@ func (r *NotificationSubsonicResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationSubsonicResourceName, response.GetImplementation(), response.GetConfigContract(), notificationSubsonicImplementation, notificationSubsonicConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationSubsonicResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -316,7 +353,13 @@ func (r *NotificationSubsonicResource) Update(ctx context.Context, req resource.
 	}
 
 	// Update NotificationSubsonic
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -328,6 +371,7 @@ func (r *NotificationSubsonicResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationSubsonicResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -353,10 +397,16 @@ func (r *NotificationSubsonicResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationSubsonicResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationSubsonicResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationSubsonicImplementation))
 	tflog.Trace(ctx, "imported "+notificationSubsonicResourceName+": "+req.ID)
 }
 
+func (r *NotificationSubsonicResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationSubsonicResourceName, notificationSubsonicImplementation, notificationSubsonicConfigContract, &NotificationSubsonic{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationSubsonic) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
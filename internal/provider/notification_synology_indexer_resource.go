@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationSynologyResource{}
 	_ resource.ResourceWithImportState = &NotificationSynologyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSynologyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSynologyResource{}
 )
 
 func NewNotificationSynologyResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationSynologyResource() resource.Resource {
 
 // NotificationSynologyResource defines the notification implementation.
 type NotificationSynologyResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationSynology describes the notification data model.
 type NotificationSynology struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Name                       types.String `tfsdk:"name"`
 	ID                         types.Int64  `tfsdk:"id"`
 	UpdateLibrary              types.Bool   `tfsdk:"update_library"`
@@ -51,11 +57,13 @@ type NotificationSynology struct {
 	OnBookFileDelete           types.Bool   `tfsdk:"on_book_file_delete"`
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnBookRetag                types.Bool   `tfsdk:"on_book_retag"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationSynology) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		Name:                       n.Name,
 		ID:                         n.ID,
 		UpdateLibrary:              n.UpdateLibrary,
@@ -74,6 +82,7 @@ func (n NotificationSynology) toNotification() *Notification {
 
 func (n *NotificationSynology) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Name = notification.Name
 	n.ID = notification.ID
 	n.UpdateLibrary = notification.UpdateLibrary
@@ -95,6 +104,10 @@ func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Synology resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Synology](https://wiki.servarr.com/readarr/supported#synologyindexer).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
 				Optional:            true,
@@ -145,6 +158,8 @@ func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -163,11 +178,17 @@ func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *NotificationSynologyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationSynologyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationSynology
@@ -179,9 +200,15 @@ func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.
 	}
 
 	// Create new NotificationSynology
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSynologyResourceName, err))
 
@@ -191,6 +218,7 @@ func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationSynologyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -212,9 +240,18 @@ func (r *NotificationSynologyResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationSynologyResourceName, response.GetImplementation(), response.GetConfigContract(), notificationSynologyImplementation, notificationSynologyConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationSynologyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -229,7 +266,13 @@ func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.
 	}
 
 	// Update NotificationSynology
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -241,6 +284,7 @@ func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationSynologyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -266,10 +310,16 @@ func (r *NotificationSynologyResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationSynologyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationSynologyResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationSynologyImplementation))
 	tflog.Trace(ctx, "imported "+notificationSynologyResourceName+": "+req.ID)
 }
 
+func (r *NotificationSynologyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationSynologyResourceName, notificationSynologyImplementation, notificationSynologyConfigContract, &NotificationSynology{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationSynology) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationTelegramResource{}
 	_ resource.ResourceWithImportState = &NotificationTelegramResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationTelegramResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTelegramResource{}
 )
 
 func NewNotificationTelegramResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationTelegramResource() resource.Resource {
 
 // NotificationTelegramResource defines the notification implementation.
 type NotificationTelegramResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationTelegram describes the notification data model.
 type NotificationTelegram struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	ChatID                     types.String `tfsdk:"chat_id"`
 	Name                       types.String `tfsdk:"name"`
 	BotToken                   types.String `tfsdk:"bot_token"`
@@ -57,11 +63,13 @@ type NotificationTelegram struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationTelegram) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		ChatID:                     n.ChatID,
 		BotToken:                   n.BotToken,
 		SendSilently:               n.SendSilently,
@@ -86,6 +94,7 @@ func (n NotificationTelegram) toNotification() *Notification {
 
 func (n *NotificationTelegram) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.ChatID = notification.ChatID
 	n.BotToken = notification.BotToken
 	n.SendSilently = notification.SendSilently
@@ -113,6 +122,10 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Telegram resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Telegram](https://wiki.servarr.com/readarr/supported#telegram).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -183,6 +196,8 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -210,11 +225,17 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *NotificationTelegramResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationTelegramResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationTelegram
@@ -226,9 +247,15 @@ func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.
 	}
 
 	// Create new NotificationTelegram
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTelegramResourceName, err))
 
@@ -238,6 +265,7 @@ func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationTelegramResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -259,9 +287,18 @@ func (r *NotificationTelegramResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationTelegramResourceName, response.GetImplementation(), response.GetConfigContract(), notificationTelegramImplementation, notificationTelegramConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationTelegramResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -276,7 +313,13 @@ func (r *NotificationTelegramResource) Update(ctx context.Context, req resource.
 	}
 
 	// Update NotificationTelegram
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -288,6 +331,7 @@ func (r *NotificationTelegramResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationTelegramResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -313,10 +357,16 @@ func (r *NotificationTelegramResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationTelegramResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationTelegramResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationTelegramImplementation))
 	tflog.Trace(ctx, "imported "+notificationTelegramResourceName+": "+req.ID)
 }
 
+func (r *NotificationTelegramResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationTelegramResourceName, notificationTelegramImplementation, notificationTelegramConfigContract, &NotificationTelegram{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationTelegram) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -26,6 +26,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationTwitterResource{}
 	_ resource.ResourceWithImportState = &NotificationTwitterResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationTwitterResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTwitterResource{}
 )
 
 func NewNotificationTwitterResource() resource.Resource {
@@ -34,12 +36,16 @@ func NewNotificationTwitterResource() resource.Resource {
 
 // NotificationTwitterResource defines the notification implementation.
 type NotificationTwitterResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // NotificationTwitter describes the notification data model.
 type NotificationTwitter struct {
 	Tags                       types.Set    `tfsdk:"tags"`
+	TagLabels                  types.Set    `tfsdk:"tag_labels"`
+	TagsAll                    types.Set    `tfsdk:"tags_all"`
 	Name                       types.String `tfsdk:"name"`
 	AccessToken                types.String `tfsdk:"access_token"`
 	AccessTokenSecret          types.String `tfsdk:"access_token_secret"`
@@ -60,11 +66,13 @@ type NotificationTwitter struct {
 	OnBookFileDeleteForUpgrade types.Bool   `tfsdk:"on_book_file_delete_for_upgrade"`
 	OnDownloadFailure          types.Bool   `tfsdk:"on_download_failure"`
 	OnImportFailure            types.Bool   `tfsdk:"on_import_failure"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (n NotificationTwitter) toNotification() *Notification {
 	return &Notification{
 		Tags:                       n.Tags,
+		TagLabels:                  n.TagLabels,
 		AccessToken:                n.AccessToken,
 		AccessTokenSecret:          n.AccessTokenSecret,
 		ConsumerKey:                n.ConsumerKey,
@@ -92,6 +100,7 @@ func (n NotificationTwitter) toNotification() *Notification {
 
 func (n *NotificationTwitter) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.AccessToken = notification.AccessToken
 	n.AccessTokenSecret = notification.AccessTokenSecret
 	n.ConsumerKey = notification.ConsumerKey
@@ -122,6 +131,10 @@ func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Twitter resource.\nFor more information refer to [Notification](https://wiki.servarr.com/readarr/settings#connect) and [Twitter](https://wiki.servarr.com/readarr/supported#twitter).",
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing notification with the same name and implementation on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -192,6 +205,8 @@ func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -234,11 +249,17 @@ func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationTwitterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *NotificationTwitterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationTwitter
@@ -250,9 +271,15 @@ func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create new NotificationTwitter
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	response, err := createNotification(ctx, r.client, request, notification.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTwitterResourceName, err))
 
@@ -262,6 +289,7 @@ func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+notificationTwitterResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -283,9 +311,18 @@ func (r *NotificationTwitterResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// Check the remote object matches the resource type, e.g. on a wrong import
+	helpers.CheckImplementation(notificationTwitterResourceName, response.GetImplementation(), response.GetConfigContract(), notificationTwitterImplementation, notificationTwitterConfigContract, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationTwitterResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -300,7 +337,13 @@ func (r *NotificationTwitterResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Update NotificationTwitter
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, notification.Tags)
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, notification.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
@@ -312,6 +355,7 @@ func (r *NotificationTwitterResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+notificationTwitterResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &notification.Tags, &notification.TagLabels, &notification.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -337,10 +381,16 @@ func (r *NotificationTwitterResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationTwitterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, notificationTwitterResourceName, path.Root("id"), req, resp, notificationLookup(r.client, notificationTwitterImplementation))
 	tflog.Trace(ctx, "imported "+notificationTwitterResourceName+": "+req.ID)
 }
 
+func (r *NotificationTwitterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationStateMover(ctx, notificationTwitterResourceName, notificationTwitterImplementation, notificationTwitterConfigContract, &NotificationTwitter{TagsAll: types.SetNull(types.Int64Type)}),
+	}
+}
+
 func (n *NotificationTwitter) write(ctx context.Context, notification *readarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -123,6 +123,11 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Notification ID.",
 							Computed:            true,
@@ -443,11 +448,20 @@ func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadReq
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+notificationsDataSourceName)
 	// Map response body to resource schema attribute
 	notifications := make([]Notification, len(response))
 	for i, n := range response {
 		notifications[i].write(ctx, n, &resp.Diagnostics)
+		notifications[i].TagLabels = helpers.TagLabels(ctx, tagList, notifications[i].Tags, &resp.Diagnostics)
 	}
 
 	notificationList, diags := types.SetValueFrom(ctx, Notification{}.getType(), notifications)
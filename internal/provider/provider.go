@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -28,8 +29,11 @@ type ReadarrProvider struct {
 
 // Readarr describes the provider data model.
 type Readarr struct {
-	APIKey types.String `tfsdk:"api_key"`
-	URL    types.String `tfsdk:"url"`
+	APIKey            types.String `tfsdk:"api_key"`
+	URL               types.String `tfsdk:"url"`
+	DefaultTags       types.Set    `tfsdk:"default_tags"`
+	CheckPaths        types.Bool   `tfsdk:"check_paths"`
+	CreateMissingTags types.Bool   `tfsdk:"create_missing_tags"`
 }
 
 func (p *ReadarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,6 +54,19 @@ func (p *ReadarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				MarkdownDescription: "Full Readarr URL with protocol and port (e.g. `https://test.readarr.lib:8787`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `READARR_URL` environment variable.",
 				Optional:            true,
 			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags, given as labels or IDs, added to every taggable resource. They are planned as `tags` of the resources without `tags` and `tag_labels`, and always as part of `tags_all`, which holds every tag set in Readarr. Root folders are not tagged, as their `default_tags` are given to the authors added under them.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"check_paths": schema.BoolAttribute{
+				MarkdownDescription: "Check at plan time that the paths of root folders, remote path mappings, custom script notifications and torrent blackhole download clients exist on the Readarr host. Defaults to `false`.",
+				Optional:            true,
+			},
+			"create_missing_tags": schema.BoolAttribute{
+				MarkdownDescription: "Create the tags referenced by `tag_labels` or `default_tags` that do not exist yet, instead of failing. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -126,7 +143,16 @@ func (p *ReadarrProvider) Configure(ctx context.Context, req provider.ConfigureR
 	client := readarr.NewAPIClient(config)
 
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	var defaultTags []string
+
+	resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, true)...)
+
+	resp.ResourceData = &helpers.ProviderData{
+		Client:            client,
+		DefaultTags:       defaultTags,
+		CheckPaths:        data.CheckPaths.ValueBool(),
+		CreateMissingTags: data.CreateMissingTags.ValueBool(),
+	}
 }
 
 func (p *ReadarrProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -179,11 +205,15 @@ func (p *ReadarrProvider) Resources(_ context.Context) []func() resource.Resourc
 
 		// Media Management
 		NewNamingResource,
+		NewManualImportResource,
 		NewMediaManagementResource,
 		NewRemotePathMappingResource,
+		NewRenameResource,
+		NewRetagResource,
 		NewRootFolderResource,
 
 		// Metadata
+		NewDevelopmentConfigResource,
 		NewMetadataConfigResource,
 
 		// Notifications
@@ -220,10 +250,16 @@ func (p *ReadarrProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewCustomFormatResource,
 
 		// System
+		NewBackupResource,
+		NewBackupRestoreResource,
 		NewHostResource,
+		NewUpdateInstallResource,
 
 		// Tags
 		NewTagResource,
+
+		// UI
+		NewUIConfigResource,
 	}
 }
 
@@ -232,36 +268,49 @@ func (p *ReadarrProvider) DataSources(_ context.Context) []func() datasource.Dat
 		// Author
 		NewAuthorDataSource,
 		NewAuthorsDataSource,
+		NewCalendarDataSource,
+		NewWantedCutoffDataSource,
+		NewWantedMissingDataSource,
 
 		// Download Clients
 		NewDownloadClientConfigDataSource,
 		NewDownloadClientDataSource,
 		NewDownloadClientsDataSource,
+		NewDownloadClientSchemaDataSource,
 
 		// Indexers
 		NewIndexerConfigDataSource,
 		NewIndexerDataSource,
 		NewIndexersDataSource,
+		NewIndexerSchemaDataSource,
 
 		// Import Lists
 		NewImportListExclusionDataSource,
 		NewImportListExclusionsDataSource,
 		NewImportListDataSource,
 		NewImportListsDataSource,
+		NewImportListSchemaDataSource,
 
 		// Notifications
 		NewNotificationDataSource,
 		NewNotificationsDataSource,
+		NewNotificationSchemaDataSource,
 
 		// Media Management
+		NewBookFilesDataSource,
 		NewNamingDataSource,
+		NewNamingExamplesDataSource,
 		NewMediaManagementDataSource,
 		NewRemotePathMappingDataSource,
 		NewRemotePathMappingsDataSource,
+		NewRenamePreviewDataSource,
+		NewRetagPreviewDataSource,
 		NewRootFolderDataSource,
 		NewRootFoldersDataSource,
+		NewUnmappedFilesDataSource,
 
 		// Metadata
+		NewDevelopmentConfigDataSource,
 		NewMetadataConfigDataSource,
 
 		// Profiles
@@ -284,12 +333,22 @@ func (p *ReadarrProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewCustomFormatConditionSizeDataSource,
 
 		// System
+		NewBackupsDataSource,
+		NewDiskSpaceDataSource,
+		NewFilesystemDataSource,
 		NewHostDataSource,
+		NewLogsDataSource,
 		NewSystemStatusDataSource,
+		NewSystemTasksDataSource,
+		NewUpdatesDataSource,
 
 		// Tags
 		NewTagDataSource,
+		NewTagDetailsDataSource,
 		NewTagsDataSource,
+
+		// UI
+		NewUIConfigDataSource,
 	}
 }
 
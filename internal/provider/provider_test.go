@@ -43,3 +43,22 @@ provider "readarr" {
 	api_key = "ErrorAPIKey"
   }
 `
+
+const testCheckPathsProvider = `
+provider "readarr" {
+	check_paths = true
+  }
+`
+
+const testDefaultTagsProvider = `
+provider "readarr" {
+	default_tags = ["terraform-managed"]
+	create_missing_tags = true
+  }
+`
+
+const testCreateMissingTagsProvider = `
+provider "readarr" {
+	create_missing_tags = true
+  }
+`
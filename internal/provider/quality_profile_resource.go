@@ -328,7 +328,7 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, qualityProfileResourceName, path.Root("id"), req, resp, qualityProfileLookup(r.client))
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
@@ -560,3 +560,21 @@ func (r QualityProfileResource) getFormatsIDs(ctx context.Context, diags *diag.D
 
 	return formatIDs
 }
+
+// qualityProfileLookup returns an import lookup by name for quality profiles.
+func qualityProfileLookup(client *readarr.APIClient) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.QualityProfileApi.ListQualityProfile(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
@@ -63,6 +63,11 @@ func (d *ReleaseProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -90,6 +95,7 @@ func (d *ReleaseProfileDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	data.find(ctx, data.ID.ValueInt64(), response, &resp.Diagnostics)
+	helpers.WriteTagLabels(ctx, d.client, data.Tags, &data.TagLabels, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "read "+releaseProfileDataSourceName)
 	// Map response body to resource schema attribute
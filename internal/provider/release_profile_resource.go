@@ -25,6 +25,7 @@ const releaseProfileResourceName = "release_profile"
 var (
 	_ resource.Resource                = &ReleaseProfileResource{}
 	_ resource.ResourceWithImportState = &ReleaseProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &ReleaseProfileResource{}
 )
 
 func NewReleaseProfileResource() resource.Resource {
@@ -33,12 +34,27 @@ func NewReleaseProfileResource() resource.Resource {
 
 // ReleaseProfileResource defines the release profile implementation.
 type ReleaseProfileResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	createMissingTags bool
+	defaultTags       []string
 }
 
 // ReleaseProfile describes the release profile data model.
 type ReleaseProfile struct {
 	Tags      types.Set   `tfsdk:"tags"`
+	TagLabels types.Set   `tfsdk:"tag_labels"`
+	Ignored   types.Set   `tfsdk:"ignored"`
+	Required  types.Set   `tfsdk:"required"`
+	ID        types.Int64 `tfsdk:"id"`
+	IndexerID types.Int64 `tfsdk:"indexer_id"`
+	Enabled   types.Bool  `tfsdk:"enabled"`
+}
+
+// ManagedReleaseProfile describes the release profile resource data model, the ReleaseProfile fields plus the resource only attributes.
+type ManagedReleaseProfile struct {
+	Tags      types.Set   `tfsdk:"tags"`
+	TagLabels types.Set   `tfsdk:"tag_labels"`
+	TagsAll   types.Set   `tfsdk:"tags_all"`
 	Ignored   types.Set   `tfsdk:"ignored"`
 	Required  types.Set   `tfsdk:"required"`
 	ID        types.Int64 `tfsdk:"id"`
@@ -50,6 +66,7 @@ func (p ReleaseProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":       types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels": types.SetType{}.WithElementType(types.StringType),
 			"ignored":    types.SetType{}.WithElementType(types.StringType),
 			"required":   types.SetType{}.WithElementType(types.StringType),
 			"id":         types.Int64Type,
@@ -104,19 +121,27 @@ func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": tagLabelsAttribute("tags"),
+			"tags_all":   tagsAllAttribute(),
 		},
 	}
 }
 
 func (r *ReleaseProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.createMissingTags = data.CreateMissingTags
+		r.defaultTags = data.DefaultTags
 	}
 }
 
+func (r *ReleaseProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.ModifyPlanTags(ctx, r.client, r.createMissingTags, r.defaultTags, req, resp)
+}
+
 func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *ReleaseProfile
+	var profile *ManagedReleaseProfile
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -125,7 +150,13 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Build Create resource
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, profile.Tags)
 	request := profile.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, profile.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Create new ReleaseProfile
 	response, _, err := r.client.ReleaseProfileApi.CreateReleaseProfile(ctx).ReleaseProfileResource(*request).Execute()
@@ -138,12 +169,13 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created"+releaseProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &profile.Tags, &profile.TagLabels, &profile.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *ReleaseProfile
+	var profile *ManagedReleaseProfile
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -161,13 +193,15 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 
 	tflog.Trace(ctx, "read "+releaseProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, profile.Tags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &profile.Tags, &profile.TagLabels, &profile.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *ReleaseProfile
+	var profile *ManagedReleaseProfile
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -176,7 +210,13 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Build Update resource
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, r.defaultTags, profile.Tags)
 	request := profile.read(ctx, &resp.Diagnostics)
+	request.Tags = tags.Request(ctx, profile.TagLabels, request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update ReleaseProfile
 	response, _, err := r.client.ReleaseProfileApi.UpdateReleaseProfile(ctx, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
@@ -189,6 +229,7 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+releaseProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &profile.Tags, &profile.TagLabels, &profile.TagsAll, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -243,3 +284,35 @@ func (p *ReleaseProfile) read(ctx context.Context, diags *diag.Diagnostics) *rea
 
 	return profile
 }
+
+func (p ManagedReleaseProfile) toReleaseProfile() *ReleaseProfile {
+	return &ReleaseProfile{
+		Tags:      p.Tags,
+		TagLabels: p.TagLabels,
+		Ignored:   p.Ignored,
+		Required:  p.Required,
+		ID:        p.ID,
+		IndexerID: p.IndexerID,
+		Enabled:   p.Enabled,
+	}
+}
+
+func (p *ManagedReleaseProfile) fromReleaseProfile(profile *ReleaseProfile) {
+	p.Tags = profile.Tags
+	p.TagLabels = profile.TagLabels
+	p.Ignored = profile.Ignored
+	p.Required = profile.Required
+	p.ID = profile.ID
+	p.IndexerID = profile.IndexerID
+	p.Enabled = profile.Enabled
+}
+
+func (p *ManagedReleaseProfile) write(ctx context.Context, profile *readarr.ReleaseProfileResource, diags *diag.Diagnostics) {
+	genericReleaseProfile := p.toReleaseProfile()
+	genericReleaseProfile.write(ctx, profile, diags)
+	p.fromReleaseProfile(genericReleaseProfile)
+}
+
+func (p *ManagedReleaseProfile) read(ctx context.Context, diags *diag.Diagnostics) *readarr.ReleaseProfileResource {
+	return p.toReleaseProfile().read(ctx, diags)
+}
@@ -77,6 +77,11 @@ func (d *ReleaseProfilesDataSource) Schema(_ context.Context, _ datasource.Schem
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 					},
 				},
 			},
@@ -99,11 +104,20 @@ func (d *ReleaseProfilesDataSource) Read(ctx context.Context, _ datasource.ReadR
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+releaseProfileResourceName)
 	// Map response body to resource schema attribute
 	profiles := make([]ReleaseProfile, len(response))
 	for i, p := range response {
 		profiles[i].write(ctx, p, &resp.Diagnostics)
+		profiles[i].TagLabels = helpers.TagLabels(ctx, tagList, profiles[i].Tags, &resp.Diagnostics)
 	}
 
 	profileList, diags := types.SetValueFrom(ctx, ReleaseProfile{}.getType(), profiles)
@@ -22,6 +22,7 @@ const remotePathMappingResourceName = "remote_path_mapping"
 var (
 	_ resource.Resource                = &RemotePathMappingResource{}
 	_ resource.ResourceWithImportState = &RemotePathMappingResource{}
+	_ resource.ResourceWithModifyPlan  = &RemotePathMappingResource{}
 )
 
 func NewRemotePathMappingResource() resource.Resource {
@@ -30,7 +31,8 @@ func NewRemotePathMappingResource() resource.Resource {
 
 // RemotePathMappingResource defines the remote path mapping implementation.
 type RemotePathMappingResource struct {
-	client *readarr.APIClient
+	client     *readarr.APIClient
+	checkPaths bool
 }
 
 // RemotePathMapping describes the remote path mapping data model.
@@ -71,7 +73,7 @@ func (r *RemotePathMappingResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:            true,
 			},
 			"local_path": schema.StringAttribute{
-				MarkdownDescription: "Local path.",
+				MarkdownDescription: "Local path. Checked on the Readarr host if provider `check_paths` is set.",
 				Required:            true,
 			},
 		},
@@ -83,8 +85,15 @@ func (r *RemotePathMappingResource) Metadata(_ context.Context, req resource.Met
 }
 
 func (r *RemotePathMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.checkPaths = data.CheckPaths
+	}
+}
+
+func (r *RemotePathMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.checkPaths {
+		helpers.CheckPlanPaths(ctx, r.client, req, resp, "local_path")
 	}
 }
 
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const renamePreviewDataSourceName = "rename_preview"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RenamePreviewDataSource{}
+
+func NewRenamePreviewDataSource() datasource.DataSource {
+	return &RenamePreviewDataSource{}
+}
+
+// RenamePreviewDataSource defines the rename preview implementation.
+type RenamePreviewDataSource struct {
+	client *readarr.APIClient
+}
+
+// RenamePreview describes the rename preview data model.
+type RenamePreview struct {
+	Files    types.Set    `tfsdk:"files"`
+	ID       types.String `tfsdk:"id"`
+	AuthorID types.Int64  `tfsdk:"author_id"`
+	BookID   types.Int64  `tfsdk:"book_id"`
+}
+
+// RenameFile is part of RenamePreview.
+type RenameFile struct {
+	ExistingPath types.String `tfsdk:"existing_path"`
+	NewPath      types.String `tfsdk:"new_path"`
+	BookFileID   types.Int64  `tfsdk:"book_file_id"`
+	BookID       types.Int64  `tfsdk:"book_id"`
+}
+
+func (r RenameFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"existing_path": types.StringType,
+			"new_path":      types.StringType,
+			"book_file_id":  types.Int64Type,
+			"book_id":       types.Int64Type,
+		})
+}
+
+func (d *RenamePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + renamePreviewDataSourceName
+}
+
+func (d *RenamePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->Preview the book files that a [Rename](../resources/rename) would change for an author.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID.",
+				Required:            true,
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID to restrict the preview to.",
+				Optional:            true,
+			},
+			"files": schema.SetNestedAttribute{
+				MarkdownDescription: "Files to be renamed.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"book_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Book file ID.",
+							Computed:            true,
+						},
+						"book_id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"existing_path": schema.StringAttribute{
+							MarkdownDescription: "Existing path.",
+							Computed:            true,
+						},
+						"new_path": schema.StringAttribute{
+							MarkdownDescription: "New path.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RenamePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *RenamePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var preview *RenamePreview
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &preview)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get rename preview current value
+	request := d.client.RenameBookApi.ListRename(ctx).AuthorId(int32(preview.AuthorID.ValueInt64()))
+	if !preview.BookID.IsNull() {
+		request = request.BookId(int32(preview.BookID.ValueInt64()))
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, renamePreviewDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+renamePreviewDataSourceName)
+	// Map response body to resource schema attribute
+	files := make([]RenameFile, len(response))
+	for i, f := range response {
+		files[i].write(f)
+	}
+
+	fileList, diags := types.SetValueFrom(ctx, RenameFile{}.getType(), files)
+	resp.Diagnostics.Append(diags...)
+
+	preview.Files = fileList
+	preview.ID = types.StringValue(strconv.Itoa(int(preview.AuthorID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &preview)...)
+}
+
+func (r *RenameFile) write(file *readarr.RenameBookResource) {
+	r.BookFileID = types.Int64Value(int64(file.GetBookFileId()))
+	r.BookID = types.Int64Value(int64(file.GetBookId()))
+	r.ExistingPath = types.StringValue(file.GetExistingPath())
+	r.NewPath = types.StringValue(file.GetNewPath())
+}
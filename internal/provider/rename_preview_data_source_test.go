@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRenamePreviewDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccRenamePreviewDataSourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAuthorResourceConfig("Leo Tolstoy", "leotolstoy", "128382") + testAccRenamePreviewDataSourceConfig("readarr_author.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_rename_preview.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRenamePreviewDataSourceConfig(author string) string {
+	return `
+	data "readarr_rename_preview" "test" {
+		author_id = ` + author + `
+	}`
+}
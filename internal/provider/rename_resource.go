@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	renameResourceName  = "rename"
+	renameFilesCommand  = "RenameFiles"
+	renameAuthorCommand = "RenameAuthor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RenameResource{}
+
+func NewRenameResource() resource.Resource {
+	return &RenameResource{}
+}
+
+// RenameResource defines the rename implementation.
+type RenameResource struct {
+	client *readarr.APIClient
+}
+
+// Rename describes the rename data model.
+type Rename struct {
+	FileIDs  types.Set   `tfsdk:"file_ids"`
+	Triggers types.Map   `tfsdk:"triggers"`
+	ID       types.Int64 `tfsdk:"id"`
+	AuthorID types.Int64 `tfsdk:"author_id"`
+}
+
+func (r *RenameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + renameResourceName
+}
+
+func (r *RenameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->Rename resource.\nIt renames the existing book files of an author according to the current naming and waits for completion. Any change triggers a new rename, destroying it only removes it from the state.\nUse the [Rename Preview](../data-sources/rename_preview) data source to review the changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Rename command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"file_ids": schema.SetAttribute{
+				MarkdownDescription: "Book file IDs to rename. All the author files are renamed if not set.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new rename when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RenameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *RenameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var rename *Rename
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run rename command and wait for completion
+	response := executeAuthorFilesCommand(ctx, r.client, renameResourceName, renameAuthorCommand, renameFilesCommand, rename.AuthorID, rename.FileIDs, &resp.Diagnostics)
+	if response == nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+renameResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	rename.ID = types.Int64Value(int64(response.GetId()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+func (r *RenameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Rename is a one time action, nothing to refresh
+	var rename *Rename
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+renameResourceName+": "+strconv.Itoa(int(rename.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+func (r *RenameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var rename *Rename
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rename cannot be updated, every change requires a replacement
+	tflog.Trace(ctx, "updated "+renameResourceName+": "+strconv.Itoa(int(rename.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+func (r *RenameResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Rename cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+renameResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// executeAuthorFilesCommand runs the files command on the given book files, or the author command on all of them, and waits for completion.
+func executeAuthorFilesCommand(ctx context.Context, client *readarr.APIClient, resourceName, authorCommand, filesCommand string, authorID types.Int64, fileIDs types.Set, diags *diag.Diagnostics) *readarr.CommandResource {
+	// Build command parameters
+	name := authorCommand
+	params := map[string]interface{}{"authorIds": []int64{authorID.ValueInt64()}}
+
+	if len(fileIDs.Elements()) != 0 {
+		files := make([]int64, len(fileIDs.Elements()))
+		diags.Append(fileIDs.ElementsAs(ctx, &files, true)...)
+
+		// Never run the command on a partial file list
+		if diags.HasError() {
+			return nil
+		}
+
+		name = filesCommand
+		params = map[string]interface{}{"authorId": authorID.ValueInt64(), "files": files}
+	}
+
+	response, err := helpers.ExecuteCommand(ctx, client, name, params)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, resourceName, err))
+
+		return nil
+	}
+
+	return response
+}
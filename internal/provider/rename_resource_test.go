@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRenameResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccRenameResourceConfig("1", "v1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAuthorResourceConfig("Fyodor Dostoevsky", "fyodordostoevsky", "3137322") + testAccRenameResourceConfig("readarr_author.test.id", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_rename.test", "id"),
+				),
+			},
+			// Replace and Read testing
+			{
+				Config: testAccAuthorResourceConfig("Fyodor Dostoevsky", "fyodordostoevsky", "3137322") + testAccRenameResourceConfig("readarr_author.test.id", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_rename.test", "triggers.naming", "v2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRenameResourceConfig(author, trigger string) string {
+	return `
+	resource "readarr_rename" "test" {
+		author_id = ` + author + `
+		triggers = {
+			naming = "` + trigger + `"
+		}
+	}`
+}
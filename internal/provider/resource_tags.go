@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagLabelsAttribute returns the resource attribute of the tag labels resolved into the given tags attribute.
+func tagLabelsAttribute(tagsAttribute string) schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: fmt.Sprintf("List of associated tag labels, resolved into `%s`. Conflicts with `%s`.", tagsAttribute, tagsAttribute),
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		Validators: []validator.Set{
+			setvalidator.ConflictsWith(path.MatchRoot(tagsAttribute)),
+			setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^A-Z]*$`), "String cannot contains uppercase values")),
+		},
+	}
+}
+
+// tagsAllAttribute returns the resource attribute of all the tags set in Readarr, including the provider default tags.
+func tagsAllAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "List of all associated tags, including the provider `default_tags`.",
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const retagPreviewDataSourceName = "retag_preview"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RetagPreviewDataSource{}
+
+func NewRetagPreviewDataSource() datasource.DataSource {
+	return &RetagPreviewDataSource{}
+}
+
+// RetagPreviewDataSource defines the retag preview implementation.
+type RetagPreviewDataSource struct {
+	client *readarr.APIClient
+}
+
+// RetagPreview describes the retag preview data model.
+type RetagPreview struct {
+	Files    types.Set    `tfsdk:"files"`
+	ID       types.String `tfsdk:"id"`
+	AuthorID types.Int64  `tfsdk:"author_id"`
+	BookID   types.Int64  `tfsdk:"book_id"`
+}
+
+// RetagFile is part of RetagPreview.
+type RetagFile struct {
+	Changes    types.List   `tfsdk:"changes"`
+	Path       types.String `tfsdk:"path"`
+	BookFileID types.Int64  `tfsdk:"book_file_id"`
+	BookID     types.Int64  `tfsdk:"book_id"`
+}
+
+func (r RetagFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"changes":      types.ListType{}.WithElementType(TagChange{}.getType()),
+			"path":         types.StringType,
+			"book_file_id": types.Int64Type,
+			"book_id":      types.Int64Type,
+		})
+}
+
+// TagChange is part of RetagFile.
+type TagChange struct {
+	Field    types.String `tfsdk:"field"`
+	OldValue types.String `tfsdk:"old_value"`
+	NewValue types.String `tfsdk:"new_value"`
+}
+
+func (c TagChange) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"field":     types.StringType,
+			"old_value": types.StringType,
+			"new_value": types.StringType,
+		})
+}
+
+func (d *RetagPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + retagPreviewDataSourceName
+}
+
+func (d *RetagPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->Preview the tag changes that a [Retag](../resources/retag) would write for an author.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID.",
+				Required:            true,
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID to restrict the preview to.",
+				Optional:            true,
+			},
+			"files": schema.SetNestedAttribute{
+				MarkdownDescription: "Files to be retagged.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"book_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Book file ID.",
+							Computed:            true,
+						},
+						"book_id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "File path.",
+							Computed:            true,
+						},
+						"changes": schema.ListNestedAttribute{
+							MarkdownDescription: "Tag changes.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field": schema.StringAttribute{
+										MarkdownDescription: "Tag field.",
+										Computed:            true,
+									},
+									"old_value": schema.StringAttribute{
+										MarkdownDescription: "Current value.",
+										Computed:            true,
+									},
+									"new_value": schema.StringAttribute{
+										MarkdownDescription: "Value to be written.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RetagPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *RetagPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var preview *RetagPreview
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &preview)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get retag preview current value
+	request := d.client.RetagBookApi.ListRetag(ctx).AuthorId(int32(preview.AuthorID.ValueInt64()))
+	if !preview.BookID.IsNull() {
+		request = request.BookId(int32(preview.BookID.ValueInt64()))
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, retagPreviewDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+retagPreviewDataSourceName)
+	// Map response body to resource schema attribute
+	files := make([]RetagFile, len(response))
+	for i, f := range response {
+		files[i].write(ctx, f, &resp.Diagnostics)
+	}
+
+	fileList, diags := types.SetValueFrom(ctx, RetagFile{}.getType(), files)
+	resp.Diagnostics.Append(diags...)
+
+	preview.Files = fileList
+	preview.ID = types.StringValue(strconv.Itoa(int(preview.AuthorID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &preview)...)
+}
+
+func (r *RetagFile) write(ctx context.Context, file *readarr.RetagBookResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	r.BookFileID = types.Int64Value(int64(file.GetBookFileId()))
+	r.BookID = types.Int64Value(int64(file.GetBookId()))
+	r.Path = types.StringValue(file.GetPath())
+
+	changes := make([]TagChange, len(file.GetChanges()))
+	for i, c := range file.GetChanges() {
+		changes[i].write(c)
+	}
+
+	r.Changes, tempDiag = types.ListValueFrom(ctx, TagChange{}.getType(), changes)
+	diags.Append(tempDiag...)
+}
+
+func (c *TagChange) write(change *readarr.TagDifference) {
+	c.Field = types.StringValue(change.GetField())
+	c.OldValue = types.StringValue(change.GetOldValue())
+	c.NewValue = types.StringValue(change.GetNewValue())
+}
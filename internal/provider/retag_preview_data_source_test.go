@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRetagPreviewDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccRetagPreviewDataSourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAuthorResourceConfig("Jane Austen", "janeausten", "1265") + testAccRetagPreviewDataSourceConfig("readarr_author.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_retag_preview.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRetagPreviewDataSourceConfig(author string) string {
+	return `
+	data "readarr_retag_preview" "test" {
+		author_id = ` + author + `
+	}`
+}
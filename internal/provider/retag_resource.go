@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	retagResourceName  = "retag"
+	retagFilesCommand  = "RetagFiles"
+	retagAuthorCommand = "RetagAuthor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RetagResource{}
+
+func NewRetagResource() resource.Resource {
+	return &RetagResource{}
+}
+
+// RetagResource defines the retag implementation.
+type RetagResource struct {
+	client *readarr.APIClient
+}
+
+// Retag describes the retag data model.
+type Retag struct {
+	FileIDs  types.Set   `tfsdk:"file_ids"`
+	Triggers types.Map   `tfsdk:"triggers"`
+	ID       types.Int64 `tfsdk:"id"`
+	AuthorID types.Int64 `tfsdk:"author_id"`
+}
+
+func (r *RetagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + retagResourceName
+}
+
+func (r *RetagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->Retag resource.\nIt writes the audio and ebook metadata tags of the existing book files of an author and waits for completion. Any change triggers a new retag, destroying it only removes it from the state.\nUse the [Retag Preview](../data-sources/retag_preview) data source to review the changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Retag command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"file_ids": schema.SetAttribute{
+				MarkdownDescription: "Book file IDs to retag. All the author files are retagged if not set.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new retag when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RetagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *RetagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var retag *Retag
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &retag)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run retag command and wait for completion
+	response := executeAuthorFilesCommand(ctx, r.client, retagResourceName, retagAuthorCommand, retagFilesCommand, retag.AuthorID, retag.FileIDs, &resp.Diagnostics)
+	if response == nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+retagResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	retag.ID = types.Int64Value(int64(response.GetId()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &retag)...)
+}
+
+func (r *RetagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retag is a one time action, nothing to refresh
+	var retag *Retag
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &retag)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+retagResourceName+": "+strconv.Itoa(int(retag.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &retag)...)
+}
+
+func (r *RetagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var retag *Retag
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &retag)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retag cannot be updated, every change requires a replacement
+	tflog.Trace(ctx, "updated "+retagResourceName+": "+strconv.Itoa(int(retag.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &retag)...)
+}
+
+func (r *RetagResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retag cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+retagResourceName)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRetagResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccRetagResourceConfig("1", "v1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAuthorResourceConfig("Charles Dickens", "charlesdickens", "239579") + testAccRetagResourceConfig("readarr_author.test.id", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_retag.test", "id"),
+				),
+			},
+			// Replace and Read testing
+			{
+				Config: testAccAuthorResourceConfig("Charles Dickens", "charlesdickens", "239579") + testAccRetagResourceConfig("readarr_author.test.id", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_retag.test", "triggers.metadata", "v2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRetagResourceConfig(author, trigger string) string {
+	return `
+	resource "readarr_retag" "test" {
+		author_id = ` + author + `
+		triggers = {
+			metadata = "` + trigger + `"
+		}
+	}`
+}
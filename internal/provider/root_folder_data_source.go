@@ -84,6 +84,14 @@ func (d *RootFolderDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				MarkdownDescription: "Default metadata profile ID.",
 				Computed:            true,
 			},
+			"free_space": schema.Int64Attribute{
+				MarkdownDescription: "Free space in bytes.",
+				Computed:            true,
+			},
+			"total_space": schema.Int64Attribute{
+				MarkdownDescription: "Total space in bytes.",
+				Computed:            true,
+			},
 			"accessible": schema.BoolAttribute{
 				MarkdownDescription: "Access flag.",
 				Computed:            true,
@@ -105,6 +113,11 @@ func (d *RootFolderDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"default_tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -132,6 +145,7 @@ func (d *RootFolderDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	folder.find(ctx, folder.Path.ValueString(), response, &resp.Diagnostics)
+	helpers.WriteTagLabels(ctx, d.client, folder.DefaultTags, &folder.DefaultTagLabels, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "read "+rootFolderDataSourceName)
 	// Map response body to resource schema attribute
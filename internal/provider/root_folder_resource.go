@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/readarr-go/readarr"
 	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
@@ -26,6 +27,7 @@ const rootFolderResourceName = "root_folder"
 var (
 	_ resource.Resource                = &RootFolderResource{}
 	_ resource.ResourceWithImportState = &RootFolderResource{}
+	_ resource.ResourceWithModifyPlan  = &RootFolderResource{}
 )
 
 func NewRootFolderResource() resource.Resource {
@@ -34,12 +36,15 @@ func NewRootFolderResource() resource.Resource {
 
 // RootFolderResource defines the root folder implementation.
 type RootFolderResource struct {
-	client *readarr.APIClient
+	client            *readarr.APIClient
+	checkPaths        bool
+	createMissingTags bool
 }
 
 // RootFolder describes the root folder data model.
 type RootFolder struct {
 	DefaultTags                 types.Set    `tfsdk:"default_tags"`
+	DefaultTagLabels            types.Set    `tfsdk:"default_tag_labels"`
 	Path                        types.String `tfsdk:"path"`
 	Name                        types.String `tfsdk:"name"`
 	DefaultMonitorOption        types.String `tfsdk:"default_monitor_option"`
@@ -53,15 +58,43 @@ type RootFolder struct {
 	DefaultMetadataProfileID    types.Int64  `tfsdk:"default_metadata_profile_id"`
 	DefaultQualityProfileID     types.Int64  `tfsdk:"default_quality_profile_id"`
 	ID                          types.Int64  `tfsdk:"id"`
+	FreeSpace                   types.Int64  `tfsdk:"free_space"`
+	TotalSpace                  types.Int64  `tfsdk:"total_space"`
 	Accessible                  types.Bool   `tfsdk:"accessible"`
 	IsCalibreLibrary            types.Bool   `tfsdk:"is_calibre_library"`
 	UseSSL                      types.Bool   `tfsdk:"use_ssl"`
 }
 
+// ManagedRootFolder describes the root folder resource data model, the RootFolder fields plus the resource options.
+type ManagedRootFolder struct {
+	DefaultTags                 types.Set    `tfsdk:"default_tags"`
+	DefaultTagLabels            types.Set    `tfsdk:"default_tag_labels"`
+	Path                        types.String `tfsdk:"path"`
+	Name                        types.String `tfsdk:"name"`
+	DefaultMonitorOption        types.String `tfsdk:"default_monitor_option"`
+	DefaultNewItemMonitorOption types.String `tfsdk:"default_monitor_new_item_option"`
+	Host                        types.String `tfsdk:"host"`
+	Username                    types.String `tfsdk:"username"`
+	Password                    types.String `tfsdk:"password"`
+	Library                     types.String `tfsdk:"library"`
+	OutputProfile               types.String `tfsdk:"output_profile"`
+	Port                        types.Int64  `tfsdk:"port"`
+	DefaultMetadataProfileID    types.Int64  `tfsdk:"default_metadata_profile_id"`
+	DefaultQualityProfileID     types.Int64  `tfsdk:"default_quality_profile_id"`
+	ID                          types.Int64  `tfsdk:"id"`
+	FreeSpace                   types.Int64  `tfsdk:"free_space"`
+	TotalSpace                  types.Int64  `tfsdk:"total_space"`
+	Accessible                  types.Bool   `tfsdk:"accessible"`
+	IsCalibreLibrary            types.Bool   `tfsdk:"is_calibre_library"`
+	UseSSL                      types.Bool   `tfsdk:"use_ssl"`
+	AdoptExisting               types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (r RootFolder) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"default_tags":                    types.SetType{}.WithElementType(types.Int64Type),
+			"default_tag_labels":              types.SetType{}.WithElementType(types.StringType),
 			"path":                            types.StringType,
 			"name":                            types.StringType,
 			"default_monitor_option":          types.StringType,
@@ -75,6 +108,8 @@ func (r RootFolder) getType() attr.Type {
 			"port":                            types.Int64Type,
 			"default_metadata_profile_id":     types.Int64Type,
 			"default_quality_profile_id":      types.Int64Type,
+			"free_space":                      types.Int64Type,
+			"total_space":                     types.Int64Type,
 			"accessible":                      types.BoolType,
 			"is_calibre_library":              types.BoolType,
 			"use_ssl":                         types.BoolType,
@@ -89,9 +124,8 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->Root Folder resource.\nFor more information refer to [Root Folders](https://wiki.servarr.com/readarr/settings#root-folders) documentation.",
 		Attributes: map[string]schema.Attribute{
-			// TODO: add validator
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Root Folder absolute path.",
+				MarkdownDescription: "Root Folder absolute path. Checked on the Readarr host if provider `check_paths` is set.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -158,6 +192,20 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "Access flag.",
 				Computed:            true,
 			},
+			"free_space": schema.Int64Attribute{
+				MarkdownDescription: "Free space in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_space": schema.Int64Attribute{
+				MarkdownDescription: "Total space in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"is_calibre_library": schema.BoolAttribute{
 				MarkdownDescription: "Is calibre library flag.",
 				Required:            true,
@@ -174,25 +222,38 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing root folder with the same path on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"default_tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"default_tag_labels": tagLabelsAttribute("default_tags"),
 		},
 	}
 }
 
 func (r *RootFolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.checkPaths = data.CheckPaths
+		r.createMissingTags = data.CreateMissingTags
+	}
+}
+
+func (r *RootFolderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.checkPaths {
+		helpers.CheckPlanPaths(ctx, r.client, req, resp, "path")
 	}
 }
 
 func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var folder *RootFolder
+	var folder *ManagedRootFolder
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
 
@@ -201,9 +262,15 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Create new RootFolder
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, nil, folder.DefaultTags)
 	request := folder.read(ctx, &resp.Diagnostics)
+	request.DefaultTags = tags.Request(ctx, folder.DefaultTagLabels, request.DefaultTags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.RootFolderApi.CreateRootFolder(ctx).RootFolderResource(*request).Execute()
+	response, err := createRootFolder(ctx, r.client, request, folder.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
 
@@ -213,12 +280,13 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "created "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	folder.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &folder.DefaultTags, &folder.DefaultTagLabels, nil, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
 func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var folder *RootFolder
+	var folder *ManagedRootFolder
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &folder)...)
 
@@ -236,13 +304,15 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	tflog.Trace(ctx, "read "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, nil, folder.DefaultTags)
 	folder.write(ctx, response, &resp.Diagnostics)
+	tags.Write(ctx, &folder.DefaultTags, &folder.DefaultTagLabels, nil, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
 func (r *RootFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var folder *RootFolder
+	var folder *ManagedRootFolder
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
 
@@ -251,7 +321,13 @@ func (r *RootFolderResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Update RootFolder
+	tags := helpers.NewResourceTags(r.client, r.createMissingTags, nil, folder.DefaultTags)
 	request := folder.read(ctx, &resp.Diagnostics)
+	request.DefaultTags = tags.Request(ctx, folder.DefaultTagLabels, request.DefaultTags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.RootFolderApi.UpdateRootFolder(ctx, strconv.Itoa(int(request.GetId()))).RootFolderResource(*request).Execute()
 	if err != nil {
@@ -262,7 +338,11 @@ func (r *RootFolderResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	tflog.Trace(ctx, "updated "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	// space keeps the planned value from state, the next refresh reads the new one
+	freeSpace, totalSpace := folder.FreeSpace, folder.TotalSpace
 	folder.write(ctx, response, &resp.Diagnostics)
+	folder.FreeSpace, folder.TotalSpace = freeSpace, totalSpace
+	tags.Write(ctx, &folder.DefaultTags, &folder.DefaultTagLabels, nil, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
@@ -288,7 +368,7 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *RootFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, rootFolderResourceName, path.Root("id"), req, resp, rootFolderLookup(r.client))
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
@@ -311,6 +391,8 @@ func (r *RootFolder) write(ctx context.Context, rootFolder *readarr.RootFolderRe
 	r.Port = types.Int64Value(int64(rootFolder.GetPort()))
 	r.IsCalibreLibrary = types.BoolValue(rootFolder.GetIsCalibreLibrary())
 	r.UseSSL = types.BoolValue(rootFolder.GetUseSsl())
+	r.FreeSpace = types.Int64Value(rootFolder.GetFreeSpace())
+	r.TotalSpace = types.Int64Value(rootFolder.GetTotalSpace())
 	r.DefaultTags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, rootFolder.GetDefaultTags())
 	diags.Append(tempDiag...)
 }
@@ -336,3 +418,107 @@ func (r *RootFolder) read(ctx context.Context, diags *diag.Diagnostics) *readarr
 
 	return folder
 }
+
+func (r ManagedRootFolder) toRootFolder() *RootFolder {
+	return &RootFolder{
+		DefaultTags:                 r.DefaultTags,
+		DefaultTagLabels:            r.DefaultTagLabels,
+		Path:                        r.Path,
+		Name:                        r.Name,
+		DefaultMonitorOption:        r.DefaultMonitorOption,
+		DefaultNewItemMonitorOption: r.DefaultNewItemMonitorOption,
+		Host:                        r.Host,
+		Username:                    r.Username,
+		Password:                    r.Password,
+		Library:                     r.Library,
+		OutputProfile:               r.OutputProfile,
+		Port:                        r.Port,
+		DefaultMetadataProfileID:    r.DefaultMetadataProfileID,
+		DefaultQualityProfileID:     r.DefaultQualityProfileID,
+		ID:                          r.ID,
+		FreeSpace:                   r.FreeSpace,
+		TotalSpace:                  r.TotalSpace,
+		Accessible:                  r.Accessible,
+		IsCalibreLibrary:            r.IsCalibreLibrary,
+		UseSSL:                      r.UseSSL,
+	}
+}
+
+func (r *ManagedRootFolder) fromRootFolder(folder *RootFolder) {
+	r.DefaultTags = folder.DefaultTags
+	r.DefaultTagLabels = folder.DefaultTagLabels
+	r.Path = folder.Path
+	r.Name = folder.Name
+	r.DefaultMonitorOption = folder.DefaultMonitorOption
+	r.DefaultNewItemMonitorOption = folder.DefaultNewItemMonitorOption
+	r.Host = folder.Host
+	r.Username = folder.Username
+	r.Password = folder.Password
+	r.Library = folder.Library
+	r.OutputProfile = folder.OutputProfile
+	r.Port = folder.Port
+	r.DefaultMetadataProfileID = folder.DefaultMetadataProfileID
+	r.DefaultQualityProfileID = folder.DefaultQualityProfileID
+	r.ID = folder.ID
+	r.FreeSpace = folder.FreeSpace
+	r.TotalSpace = folder.TotalSpace
+	r.Accessible = folder.Accessible
+	r.IsCalibreLibrary = folder.IsCalibreLibrary
+	r.UseSSL = folder.UseSSL
+}
+
+func (r *ManagedRootFolder) write(ctx context.Context, rootFolder *readarr.RootFolderResource, diags *diag.Diagnostics) {
+	genericRootFolder := r.toRootFolder()
+	genericRootFolder.write(ctx, rootFolder, diags)
+	r.fromRootFolder(genericRootFolder)
+}
+
+func (r *ManagedRootFolder) read(ctx context.Context, diags *diag.Diagnostics) *readarr.RootFolderResource {
+	return r.toRootFolder().read(ctx, diags)
+}
+
+// rootFolderLookup returns an import lookup by name or path for root folders.
+func rootFolderLookup(client *readarr.APIClient) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.RootFolderApi.ListRootFolder(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetName() == name || sameFolderPath(item.GetPath(), name) {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
+
+// createRootFolder creates a root folder, or updates the existing one with the same path if adopt is set.
+func createRootFolder(ctx context.Context, client *readarr.APIClient, request *readarr.RootFolderResource, adopt bool) (*readarr.RootFolderResource, error) {
+	if adopt {
+		folders, _, err := client.RootFolderApi.ListRootFolder(ctx).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, folder := range folders {
+			if sameFolderPath(folder.GetPath(), request.GetPath()) {
+				request.SetId(folder.GetId())
+				response, _, err := client.RootFolderApi.UpdateRootFolder(ctx, strconv.Itoa(int(folder.GetId()))).RootFolderResource(*request).Execute()
+
+				return response, err
+			}
+		}
+	}
+
+	response, _, err := client.RootFolderApi.CreateRootFolder(ctx).RootFolderResource(*request).Execute()
+
+	return response, err
+}
+
+// sameFolderPath compares folder paths ignoring the trailing separator added by Readarr.
+func sameFolderPath(a, b string) bool {
+	return strings.TrimRight(a, `/\`) == strings.TrimRight(b, `/\`)
+}
@@ -20,12 +20,18 @@ func TestAccRootFolderResource(t *testing.T) {
 				Config:      testAccRootFolderResourceConfig("/error", "Error") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Missing path
+			{
+				Config:      testAccRootFolderResourceConfig("/config/missing", "Error") + testCheckPathsProvider,
+				ExpectError: regexp.MustCompile("Path Not Found"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccRootFolderResourceConfig("/config/asp", "ResourceTest"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("readarr_root_folder.test", "path", "/config/asp"),
 					resource.TestCheckResourceAttrSet("readarr_root_folder.test", "id"),
+					resource.TestCheckResourceAttrSet("readarr_root_folder.test", "free_space"),
 				),
 			},
 			// Unauthorized Read
@@ -42,9 +48,25 @@ func TestAccRootFolderResource(t *testing.T) {
 			},
 			// ImportState testing
 			{
-				ResourceName:      "readarr_root_folder.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "readarr_root_folder.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"free_space", "total_space"},
+			},
+			// ImportState by path, with and without trailing separator
+			{
+				ResourceName:            "readarr_root_folder.test",
+				ImportState:             true,
+				ImportStateId:           "/config/logs/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"free_space", "total_space"},
+			},
+			{
+				ResourceName:            "readarr_root_folder.test",
+				ImportState:             true,
+				ImportStateId:           "/config/logs",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"free_space", "total_space"},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
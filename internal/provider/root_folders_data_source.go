@@ -99,6 +99,14 @@ func (d *RootFoldersDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							MarkdownDescription: "Default metadata profile ID.",
 							Computed:            true,
 						},
+						"free_space": schema.Int64Attribute{
+							MarkdownDescription: "Free space in bytes.",
+							Computed:            true,
+						},
+						"total_space": schema.Int64Attribute{
+							MarkdownDescription: "Total space in bytes.",
+							Computed:            true,
+						},
 						"accessible": schema.BoolAttribute{
 							MarkdownDescription: "Access flag.",
 							Computed:            true,
@@ -120,6 +128,11 @@ func (d *RootFoldersDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"default_tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 					},
 				},
 			},
@@ -142,11 +155,20 @@ func (d *RootFoldersDataSource) Read(ctx context.Context, _ datasource.ReadReque
 		return
 	}
 
+	// Get tags to map their labels
+	tagList, _, err := d.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+rootFoldersDataSourceName)
 	// Map response body to resource schema attribute
 	rootFolders := make([]RootFolder, len(response))
 	for i, f := range response {
 		rootFolders[i].write(ctx, f, &resp.Diagnostics)
+		rootFolders[i].DefaultTagLabels = helpers.TagLabels(ctx, tagList, rootFolders[i].DefaultTags, &resp.Diagnostics)
 	}
 
 	folderList, diags := types.SetValueFrom(ctx, RootFolder{}.getType(), rootFolders)
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const tagDetailsDataSourceName = "tag_details"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagDetailsDataSource{}
+
+func NewTagDetailsDataSource() datasource.DataSource {
+	return &TagDetailsDataSource{}
+}
+
+// TagDetailsDataSource defines the tag details implementation.
+type TagDetailsDataSource struct {
+	client *readarr.APIClient
+}
+
+// TagDetails describes the tag details data model.
+type TagDetails struct {
+	DelayProfileIDs   types.Set    `tfsdk:"delay_profile_ids"`
+	ImportListIDs     types.Set    `tfsdk:"import_list_ids"`
+	NotificationIDs   types.Set    `tfsdk:"notification_ids"`
+	ReleaseProfileIDs types.Set    `tfsdk:"release_profile_ids"`
+	IndexerIDs        types.Set    `tfsdk:"indexer_ids"`
+	DownloadClientIDs types.Set    `tfsdk:"download_client_ids"`
+	AuthorIDs         types.Set    `tfsdk:"author_ids"`
+	Label             types.String `tfsdk:"label"`
+	ID                types.Int64  `tfsdk:"id"`
+}
+
+func (t TagDetails) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"delay_profile_ids":   types.SetType{}.WithElementType(types.Int64Type),
+			"import_list_ids":     types.SetType{}.WithElementType(types.Int64Type),
+			"notification_ids":    types.SetType{}.WithElementType(types.Int64Type),
+			"release_profile_ids": types.SetType{}.WithElementType(types.Int64Type),
+			"indexer_ids":         types.SetType{}.WithElementType(types.Int64Type),
+			"download_client_ids": types.SetType{}.WithElementType(types.Int64Type),
+			"author_ids":          types.SetType{}.WithElementType(types.Int64Type),
+			"label":               types.StringType,
+			"id":                  types.Int64Type,
+		})
+}
+
+// tagDetailsAttributes returns the computed attributes shared by the tag details data sources.
+func tagDetailsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"delay_profile_ids": schema.SetAttribute{
+			MarkdownDescription: "Delay profile IDs.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"import_list_ids": schema.SetAttribute{
+			MarkdownDescription: "Import list IDs.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"notification_ids": schema.SetAttribute{
+			MarkdownDescription: "Notification IDs.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"release_profile_ids": schema.SetAttribute{
+			MarkdownDescription: "Release profile IDs.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"indexer_ids": schema.SetAttribute{
+			MarkdownDescription: "Indexer IDs.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"download_client_ids": schema.SetAttribute{
+			MarkdownDescription: "Download client IDs.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"author_ids": schema.SetAttribute{
+			MarkdownDescription: "Author IDs.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+	}
+}
+
+func (d *TagDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + tagDetailsDataSourceName
+}
+
+func (d *TagDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := tagDetailsAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "Tag ID.",
+		Computed:            true,
+	}
+	attributes["label"] = schema.StringAttribute{
+		MarkdownDescription: "Tag label.",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Tags -->Single [Tag](../resources/tag) with the IDs of the items using it.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *TagDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *TagDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TagDetails
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get tag details current value
+	response, _, err := d.client.TagDetailsApi.ListTagDetail(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagDetailsDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.Label.ValueString(), response, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+tagDetailsDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (t *TagDetails) find(ctx context.Context, label string, tags []*readarr.TagDetailsResource, diags *diag.Diagnostics) {
+	for _, tag := range tags {
+		if tag.GetLabel() == label {
+			t.write(ctx, tag, diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(tagDetailsDataSourceName, "label", label))
+}
+
+func (t *TagDetails) write(ctx context.Context, tag *readarr.TagDetailsResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	t.ID = types.Int64Value(int64(tag.GetId()))
+	t.Label = types.StringValue(tag.GetLabel())
+	t.DelayProfileIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetDelayProfileIds())
+	diags.Append(tempDiag...)
+	t.ImportListIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetImportListIds())
+	diags.Append(tempDiag...)
+	t.NotificationIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetNotificationIds())
+	diags.Append(tempDiag...)
+	t.ReleaseProfileIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetRestrictionIds())
+	diags.Append(tempDiag...)
+	t.IndexerIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetIndexerIds())
+	diags.Append(tempDiag...)
+	t.DownloadClientIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetDownloadClientIds())
+	diags.Append(tempDiag...)
+	t.AuthorIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetAuthorIds())
+	diags.Append(tempDiag...)
+}
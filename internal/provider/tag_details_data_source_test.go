@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagDetailsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccTagDetailsDataSourceConfig("\"error\"") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccTagDetailsDataSourceConfig("\"error\""),
+				ExpectError: regexp.MustCompile("Unable to find tag_details"),
+			},
+			// Read testing
+			{
+				Config: testAccTagResourceConfig("test", "tag_details_datasource") + testAccTagDetailsDataSourceConfig("readarr_tag.test.label"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_tag_details.test", "id"),
+					resource.TestCheckResourceAttr("data.readarr_tag_details.test", "label", "tag_details_datasource"),
+					resource.TestCheckResourceAttr("data.readarr_tag_details.test", "author_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagDetailsDataSourceConfig(label string) string {
+	return fmt.Sprintf(`
+	data "readarr_tag_details" "test" {
+		label = %s
+	}
+	`, label)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/readarr-go/readarr"
 	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
@@ -43,6 +44,13 @@ type Tag struct {
 	ID    types.Int64  `tfsdk:"id"`
 }
 
+// ManagedTag describes the tag resource data model, the Tag fields plus the resource options.
+type ManagedTag struct {
+	Label         types.String `tfsdk:"label"`
+	ID            types.Int64  `tfsdk:"id"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (t Tag) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -64,11 +72,15 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^.*[^A-Z]+.*$`),
+						regexp.MustCompile(`^[^A-Z]*$`),
 						"String cannot contains uppercase values",
 					),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing tag with the same label on create, instead of creating a new one. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Tag ID.",
 				Computed:            true,
@@ -88,7 +100,7 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var tag *Tag
+	var tag *ManagedTag
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -100,7 +112,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	request := *readarr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
-	response, _, err := r.client.TagApi.CreateTag(ctx).TagResource(request).Execute()
+	response, err := createTag(ctx, r.client, &request, tag.AdoptExisting.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
 
@@ -115,7 +127,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var tag *Tag
+	var tag *ManagedTag
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &tag)...)
 
@@ -139,7 +151,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var tag *Tag
+	var tag *ManagedTag
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -187,7 +199,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, tagResourceName, path.Root("id"), req, resp, tagLookup(r.client))
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
@@ -195,3 +207,47 @@ func (t *Tag) write(tag *readarr.TagResource) {
 	t.ID = types.Int64Value(int64(tag.GetId()))
 	t.Label = types.StringValue(tag.GetLabel())
 }
+
+func (t *ManagedTag) write(tag *readarr.TagResource) {
+	t.ID = types.Int64Value(int64(tag.GetId()))
+	t.Label = types.StringValue(tag.GetLabel())
+}
+
+// tagLookup returns an import lookup by label for tags.
+func tagLookup(client *readarr.APIClient) helpers.ImportLookup {
+	return func(ctx context.Context, name string) (int64, bool, error) {
+		response, _, err := client.TagApi.ListTag(ctx).Execute()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range response {
+			if item.GetLabel() == name {
+				return int64(item.GetId()), true, nil
+			}
+		}
+
+		return 0, false, nil
+	}
+}
+
+// createTag creates a tag, or returns the existing one with the same label if adopt is set.
+func createTag(ctx context.Context, client *readarr.APIClient, request *readarr.TagResource, adopt bool) (*readarr.TagResource, error) {
+	if adopt {
+		response, _, err := client.TagApi.ListTag(ctx).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		// Labels are case insensitive
+		for _, tag := range response {
+			if strings.EqualFold(tag.GetLabel(), request.GetLabel()) {
+				return tag, nil
+			}
+		}
+	}
+
+	response, _, err := client.TagApi.CreateTag(ctx).TagResource(*request).Execute()
+
+	return response, err
+}
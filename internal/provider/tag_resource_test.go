@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
+	"github.com/devopsarr/readarr-go/readarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -46,11 +49,70 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "readarr_tag.test",
+				ImportState:       true,
+				ImportStateId:     "name:mobi",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTagResourceAdopt(t *testing.T) {
+	t.Parallel()
+
+	var existingID int32
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt a tag created outside terraform
+			{
+				PreConfig: func() { existingID = tagAdoptInit(t, "tag_adopt") },
+				Config:    testAccTagResourceAdoptConfig("tag_adopt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("readarr_tag.test", "id", func(value string) error {
+						if value != strconv.Itoa(int(existingID)) {
+							return fmt.Errorf("expected adopted tag ID %d, got: %s", existingID, value)
+						}
+
+						return nil
+					}),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+// tagAdoptInit creates the tag to adopt directly through the API.
+func tagAdoptInit(t *testing.T, label string) int32 {
+	t.Helper()
+
+	tag := readarr.NewTagResource()
+	tag.SetLabel(label)
+
+	response, _, err := testAccAPIClient().TagApi.CreateTag(context.TODO()).TagResource(*tag).Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.GetId()
+}
+
+func testAccTagResourceAdoptConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "readarr_tag" "test" {
+  			label = "%s"
+			adopt_existing = true
+		}
+	`, label)
+}
+
 func testAccTagResourceConfig(name, label string) string {
 	return fmt.Sprintf(`
 		resource "readarr_tag" "%s" {
@@ -8,6 +8,7 @@ import (
 	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,8 +29,10 @@ type TagsDataSource struct {
 
 // Tags describes the tags data model.
 type Tags struct {
-	Tags types.Set    `tfsdk:"tags"`
-	ID   types.String `tfsdk:"id"`
+	Tags       types.Set    `tfsdk:"tags"`
+	TagDetails types.Set    `tfsdk:"tag_details"`
+	ID         types.String `tfsdk:"id"`
+	Details    types.Bool   `tfsdk:"details"`
 }
 
 func (d *TagsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +40,16 @@ func (d *TagsDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 }
 
 func (d *TagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tagDetails := tagDetailsAttributes()
+	tagDetails["id"] = schema.Int64Attribute{
+		MarkdownDescription: "Tag ID.",
+		Computed:            true,
+	}
+	tagDetails["label"] = schema.StringAttribute{
+		MarkdownDescription: "Tag label.",
+		Computed:            true,
+	}
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Tags -->List all available [Tags](../resources/tag).",
@@ -45,6 +58,17 @@ func (d *TagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"details": schema.BoolAttribute{
+				MarkdownDescription: "Also return the IDs of the items using each tag in `tag_details`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"tag_details": schema.SetNestedAttribute{
+				MarkdownDescription: "Tag details list, only set if `details` is true.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: tagDetails,
+				},
+			},
 			"tags": schema.SetNestedAttribute{
 				MarkdownDescription: "Tag list.",
 				Computed:            true,
@@ -71,7 +95,15 @@ func (d *TagsDataSource) Configure(ctx context.Context, req datasource.Configure
 	}
 }
 
-func (d *TagsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Tags
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get tags current value
 	response, _, err := d.client.TagApi.ListTag(ctx).Execute()
 	if err != nil {
@@ -87,7 +119,31 @@ func (d *TagsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, res
 		tags[i].write(t)
 	}
 
-	tagList, diags := types.SetValueFrom(ctx, Tag{}.getType(), tags)
+	var diags diag.Diagnostics
+
+	data.Tags, diags = types.SetValueFrom(ctx, Tag{}.getType(), tags)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Tags{Tags: tagList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.TagDetails = types.SetNull(TagDetails{}.getType())
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+
+	// Get tag details only if requested
+	if data.Details.ValueBool() {
+		details, _, err := d.client.TagDetailsApi.ListTagDetail(ctx).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagsDataSourceName, err))
+
+			return
+		}
+
+		detailList := make([]TagDetails, len(details))
+		for i, t := range details {
+			detailList[i].write(ctx, t, &resp.Diagnostics)
+		}
+
+		data.TagDetails, diags = types.SetValueFrom(ctx, TagDetails{}.getType(), detailList)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -28,6 +28,14 @@ func TestAccTagsDataSource(t *testing.T) {
 				Config: testAccTagsDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_tags.test", "tags.*", map[string]string{"label": "fiction"}),
+					resource.TestCheckNoResourceAttr("data.readarr_tags.test", "tag_details"),
+				),
+			},
+			// Read details testing
+			{
+				Config: testAccTagsDataSourceDetailsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_tags.test", "tag_details.*", map[string]string{"label": "fiction"}),
 				),
 			},
 		},
@@ -38,3 +46,9 @@ const testAccTagsDataSourceConfig = `
 data "readarr_tags" "test" {
 }
 `
+
+const testAccTagsDataSourceDetailsConfig = `
+data "readarr_tags" "test" {
+	details = true
+}
+`
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const unmappedFilesDataSourceName = "unmapped_files"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UnmappedFilesDataSource{}
+
+func NewUnmappedFilesDataSource() datasource.DataSource {
+	return &UnmappedFilesDataSource{}
+}
+
+// UnmappedFilesDataSource defines the unmapped files implementation.
+type UnmappedFilesDataSource struct {
+	client *readarr.APIClient
+}
+
+// UnmappedFiles describes the unmapped files data model.
+type UnmappedFiles struct {
+	Files        types.Set    `tfsdk:"files"`
+	ID           types.String `tfsdk:"id"`
+	RootFolderID types.Int64  `tfsdk:"root_folder_id"`
+}
+
+func (d *UnmappedFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + unmappedFilesDataSourceName
+}
+
+func (d *UnmappedFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->List all files not mapped to any book, optionally restricted to a [Root Folder](../resources/root_folder).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"root_folder_id": schema.Int64Attribute{
+				MarkdownDescription: "Root Folder ID to restrict the list to.",
+				Optional:            true,
+			},
+			"files": schema.SetNestedAttribute{
+				MarkdownDescription: "Unmapped file list.",
+				Computed:            true,
+				NestedObject:        bookFileSchema(),
+			},
+		},
+	}
+}
+
+func (d *UnmappedFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *UnmappedFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var files *UnmappedFiles
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &files)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get root folder path to filter on
+	prefix := ""
+
+	if !files.RootFolderID.IsNull() {
+		folder, _, err := d.client.RootFolderApi.GetRootFolderById(ctx, int32(files.RootFolderID.ValueInt64())).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, unmappedFilesDataSourceName, err))
+
+			return
+		}
+
+		prefix = folderPrefix(folder.GetPath())
+	}
+
+	// Get unmapped files current value
+	response, _, err := d.client.BookFileApi.ListBookFile(ctx).Unmapped(true).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, unmappedFilesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+unmappedFilesDataSourceName)
+	// Map response body to resource schema attribute
+	fileList := make([]BookFile, 0, len(response))
+
+	for _, f := range response {
+		if strings.HasPrefix(f.GetPath(), prefix) {
+			file := BookFile{}
+			file.write(f)
+			fileList = append(fileList, file)
+		}
+	}
+
+	set, diags := types.SetValueFrom(ctx, BookFile{}.getType(), fileList)
+	resp.Diagnostics.Append(diags...)
+
+	files.Files = set
+	files.ID = types.StringValue(strconv.Itoa(len(fileList)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &files)...)
+}
+
+// folderPrefix returns the folder path ending with a single separator, the one the path uses,
+// so that /books does not match /books-old, also on Windows hosts with paths like D:\Books\.
+func folderPrefix(path string) string {
+	separator := "/"
+	if strings.Contains(path, `\`) {
+		separator = `\`
+	}
+
+	return strings.TrimRight(path, `/\`) + separator
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnmappedFilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccUnmappedFilesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccUnmappedFilesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_unmapped_files.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUnmappedFilesDataSourceConfig = `
+data "readarr_root_folder" "test" {
+	path = "/config"
+}
+
+data "readarr_unmapped_files" "test" {
+	root_folder_id = data.readarr_root_folder.test.id
+}
+`
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	updateInstallResourceName = "update_install"
+	updateInstallCommand      = "ApplicationUpdate"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UpdateInstallResource{}
+
+func NewUpdateInstallResource() resource.Resource {
+	return &UpdateInstallResource{}
+}
+
+// UpdateInstallResource defines the update install implementation.
+type UpdateInstallResource struct {
+	client *readarr.APIClient
+}
+
+// UpdateInstall describes the update install data model.
+type UpdateInstall struct {
+	ID        types.String `tfsdk:"id"`
+	Version   types.String `tfsdk:"version"`
+	StartTime types.String `tfsdk:"start_time"`
+}
+
+func (r *UpdateInstallResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + updateInstallResourceName
+}
+
+func (r *UpdateInstallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Update Install resource.\nIt installs the latest available update, then waits for Readarr to be back on the expected version. Nothing is done if the version is already installed, destroying it only removes it from the state.\nUse the [Updates](../data-sources/updates) data source to review the available versions. For more information refer to [Updates](https://wiki.servarr.com/readarr/system#updates) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Installed version.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Expected version, it must be the latest installable one. Any version change triggers a new install.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Start time of the updated application.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UpdateInstallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *UpdateInstallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var update *UpdateInstall
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &update)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current status to detect the restart
+	status, _, err := r.client.SystemApi.GetSystemStatus(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, updateInstallResourceName, err))
+
+		return
+	}
+
+	if status.GetVersion() != update.Version.ValueString() {
+		// Check the version can be installed, the command always installs the latest one
+		updates, _, err := r.client.UpdateApi.ListUpdate(ctx).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, updateInstallResourceName, err))
+
+			return
+		}
+
+		if latest := latestInstallableUpdate(updates); latest != update.Version.ValueString() {
+			resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Version %s cannot be installed, latest installable version is '%s'", update.Version.ValueString(), latest))
+
+			return
+		}
+
+		// Application goes down during the update, so the command cannot be awaited
+		if _, err = helpers.StartCommand(ctx, r.client, updateInstallCommand, nil); err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, updateInstallResourceName, err))
+
+			return
+		}
+
+		status, err = helpers.WaitRestart(ctx, r.client, status.GetStartTime(), helpers.RestartPollInterval)
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, updateInstallResourceName, err))
+
+			return
+		}
+
+		if status.GetVersion() != update.Version.ValueString() {
+			resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Readarr restarted on version %s instead of %s", status.GetVersion(), update.Version.ValueString()))
+
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created "+updateInstallResourceName+": "+status.GetVersion())
+	// Generate resource state struct
+	update.ID = types.StringValue(status.GetVersion())
+	update.StartTime = types.StringValue(status.GetStartTime().String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &update)...)
+}
+
+func (r *UpdateInstallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Update install is a one time action, nothing to refresh
+	var update *UpdateInstall
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &update)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+updateInstallResourceName+": "+update.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &update)...)
+}
+
+func (r *UpdateInstallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var update *UpdateInstall
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &update)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update install cannot be updated, every change requires a replacement
+	tflog.Trace(ctx, "updated "+updateInstallResourceName+": "+update.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &update)...)
+}
+
+func (r *UpdateInstallResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Update install cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+updateInstallResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// latestInstallableUpdate returns the latest installable version, empty if none.
+func latestInstallableUpdate(updates []*readarr.UpdateResource) string {
+	for _, u := range updates {
+		if u.GetLatest() && u.GetInstallable() {
+			return u.GetVersion()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpdateInstallResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccUpdateInstallResourceConfig("0.0.0.1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not installable version
+			{
+				Config:      testAccUpdateInstallResourceConfig("0.0.0.1"),
+				ExpectError: regexp.MustCompile("cannot be installed"),
+			},
+		},
+	})
+}
+
+func testAccUpdateInstallResourceConfig(version string) string {
+	return `
+	resource "readarr_update_install" "test" {
+		version = "` + version + `"
+	}`
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const updatesDataSourceName = "updates"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UpdatesDataSource{}
+
+func NewUpdatesDataSource() datasource.DataSource {
+	return &UpdatesDataSource{}
+}
+
+// UpdatesDataSource defines the updates implementation.
+type UpdatesDataSource struct {
+	client *readarr.APIClient
+}
+
+// Updates describes the updates data model.
+type Updates struct {
+	Updates types.Set    `tfsdk:"updates"`
+	ID      types.String `tfsdk:"id"`
+}
+
+// Update is part of Updates.
+type Update struct {
+	New         types.List   `tfsdk:"new"`
+	Fixed       types.List   `tfsdk:"fixed"`
+	Version     types.String `tfsdk:"version"`
+	Branch      types.String `tfsdk:"branch"`
+	ReleaseDate types.String `tfsdk:"release_date"`
+	InstalledOn types.String `tfsdk:"installed_on"`
+	FileName    types.String `tfsdk:"file_name"`
+	URL         types.String `tfsdk:"url"`
+	Hash        types.String `tfsdk:"hash"`
+	Installed   types.Bool   `tfsdk:"installed"`
+	Installable types.Bool   `tfsdk:"installable"`
+	Latest      types.Bool   `tfsdk:"latest"`
+}
+
+func (u Update) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"new":          types.ListType{}.WithElementType(types.StringType),
+			"fixed":        types.ListType{}.WithElementType(types.StringType),
+			"version":      types.StringType,
+			"branch":       types.StringType,
+			"release_date": types.StringType,
+			"installed_on": types.StringType,
+			"file_name":    types.StringType,
+			"url":          types.StringType,
+			"hash":         types.StringType,
+			"installed":    types.BoolType,
+			"installable":  types.BoolType,
+			"latest":       types.BoolType,
+		})
+}
+
+func (d *UpdatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + updatesDataSourceName
+}
+
+func (d *UpdatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->List all available [Updates](../resources/update_install) for the configured branch.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"updates": schema.SetNestedAttribute{
+				MarkdownDescription: "Update list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version.",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							MarkdownDescription: "Branch.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"installed_on": schema.StringAttribute{
+							MarkdownDescription: "Installation date.",
+							Computed:            true,
+						},
+						"file_name": schema.StringAttribute{
+							MarkdownDescription: "Package file name.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Package URL.",
+							Computed:            true,
+						},
+						"hash": schema.StringAttribute{
+							MarkdownDescription: "Package hash.",
+							Computed:            true,
+						},
+						"installed": schema.BoolAttribute{
+							MarkdownDescription: "Installed flag.",
+							Computed:            true,
+						},
+						"installable": schema.BoolAttribute{
+							MarkdownDescription: "Installable flag.",
+							Computed:            true,
+						},
+						"latest": schema.BoolAttribute{
+							MarkdownDescription: "Latest flag.",
+							Computed:            true,
+						},
+						"new": schema.ListAttribute{
+							MarkdownDescription: "New features.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"fixed": schema.ListAttribute{
+							MarkdownDescription: "Fixed issues.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UpdatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *UpdatesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get updates current value
+	response, _, err := d.client.UpdateApi.ListUpdate(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, updatesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+updatesDataSourceName)
+	// Map response body to resource schema attribute
+	updates := make([]Update, len(response))
+	for i, u := range response {
+		updates[i].write(ctx, u, &resp.Diagnostics)
+	}
+
+	updateList, diags := types.SetValueFrom(ctx, Update{}.getType(), updates)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Updates{Updates: updateList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (u *Update) write(ctx context.Context, update *readarr.UpdateResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	u.Version = types.StringValue(update.GetVersion())
+	u.Branch = types.StringValue(update.GetBranch())
+	u.ReleaseDate = types.StringValue(update.GetReleaseDate().String())
+	u.InstalledOn = types.StringValue(update.GetInstalledOn().String())
+	u.FileName = types.StringValue(update.GetFileName())
+	u.URL = types.StringValue(update.GetUrl())
+	u.Hash = types.StringValue(update.GetHash())
+	u.Installed = types.BoolValue(update.GetInstalled())
+	u.Installable = types.BoolValue(update.GetInstallable())
+	u.Latest = types.BoolValue(update.GetLatest())
+	u.New, tempDiag = types.ListValueFrom(ctx, types.StringType, update.Changes.GetNew())
+	diags.Append(tempDiag...)
+	u.Fixed, tempDiag = types.ListValueFrom(ctx, types.StringType, update.Changes.GetFixed())
+	diags.Append(tempDiag...)
+}
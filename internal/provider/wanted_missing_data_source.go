@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const wantedMissingDataSourceName = "wanted_missing"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WantedMissingDataSource{}
+
+func NewWantedMissingDataSource() datasource.DataSource {
+	return &WantedMissingDataSource{}
+}
+
+// WantedMissingDataSource defines the wanted missing implementation.
+type WantedMissingDataSource struct {
+	client *readarr.APIClient
+}
+
+// Wanted describes the wanted data model.
+type Wanted struct {
+	Books        types.Set    `tfsdk:"books"`
+	ID           types.String `tfsdk:"id"`
+	TotalRecords types.Int64  `tfsdk:"total_records"`
+	Monitored    types.Bool   `tfsdk:"monitored"`
+}
+
+// WantedBook is part of Wanted and Calendar.
+type WantedBook struct {
+	Title       types.String `tfsdk:"title"`
+	AuthorName  types.String `tfsdk:"author_name"`
+	ReleaseDate types.String `tfsdk:"release_date"`
+	ID          types.Int64  `tfsdk:"id"`
+	AuthorID    types.Int64  `tfsdk:"author_id"`
+	Monitored   types.Bool   `tfsdk:"monitored"`
+}
+
+func (b WantedBook) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":        types.StringType,
+			"author_name":  types.StringType,
+			"release_date": types.StringType,
+			"id":           types.Int64Type,
+			"author_id":    types.Int64Type,
+			"monitored":    types.BoolType,
+		})
+}
+
+// wantedSchema is the schema shared by the wanted data sources.
+func wantedSchema(description string) schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Filter on monitored books if true, unmonitored ones if false. Defaults to `true`.",
+				Optional:            true,
+			},
+			"total_records": schema.Int64Attribute{
+				MarkdownDescription: "Total count of books.",
+				Computed:            true,
+			},
+			"books": schema.SetNestedAttribute{
+				MarkdownDescription: "Book list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Book title.",
+							Computed:            true,
+						},
+						"author_id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID.",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Author name.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WantedMissingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + wantedMissingDataSourceName
+}
+
+func (d *WantedMissingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = wantedSchema("<!-- subcategory:Authors -->List all books missing from the library.")
+}
+
+func (d *WantedMissingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *WantedMissingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var wanted *Wanted
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &wanted)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get wanted missing current value
+	response, total, err := helpers.WantedBooks(ctx, d.client, helpers.WantedMissingPath, wanted.Monitored.IsNull() || wanted.Monitored.ValueBool(), helpers.WantedPageSize)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, wantedMissingDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+wantedMissingDataSourceName)
+	// Map response body to resource schema attribute
+	wanted.write(ctx, response, total, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &wanted)...)
+}
+
+func (w *Wanted) write(ctx context.Context, books []*readarr.BookResource, total int, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	list := make([]WantedBook, len(books))
+	for i, b := range books {
+		list[i].write(b)
+	}
+
+	w.Books, tempDiag = types.SetValueFrom(ctx, WantedBook{}.getType(), list)
+	diags.Append(tempDiag...)
+
+	w.TotalRecords = types.Int64Value(int64(total))
+	w.ID = types.StringValue(strconv.Itoa(total))
+}
+
+func (b *WantedBook) write(book *readarr.BookResource) {
+	b.ID = types.Int64Value(int64(book.GetId()))
+	b.Title = types.StringValue(book.GetTitle())
+	b.AuthorID = types.Int64Value(int64(book.GetAuthorId()))
+	b.AuthorName = types.StringValue(book.Author.GetAuthorName())
+	b.ReleaseDate = types.StringValue(book.GetReleaseDate().String())
+	b.Monitored = types.BoolValue(book.GetMonitored())
+}